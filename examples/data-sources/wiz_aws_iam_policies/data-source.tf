@@ -0,0 +1,52 @@
+# Render the policies of the Wiz customer role, with data scanning and Cloud Events
+data "wiz_aws_iam_policies" "example" {
+  wiz_principal_arn      = "arn:aws:iam::197171649850:root"
+  external_id            = "00000000-0000-0000-0000-000000000000"
+  data_scanning_enabled  = true
+  cloudtrail_bucket_name = "example-cloudtrail"
+}
+
+resource "aws_iam_role" "wiz" {
+  name                = "WizAccess-Role"
+  assume_role_policy  = data.wiz_aws_iam_policies.example.trust_policy_json
+  managed_policy_arns = data.wiz_aws_iam_policies.example.managed_policy_arns
+
+  dynamic "inline_policy" {
+    for_each = data.wiz_aws_iam_policies.example.permission_policies
+    content {
+      name   = inline_policy.key
+      policy = inline_policy.value
+    }
+  }
+}
+
+resource "wiz_connector_aws" "example" {
+  name = "example"
+
+  customer_role {
+    role_arn = aws_iam_role.wiz.arn
+  }
+
+  events_cloudtrail_bucket_name = "example-cloudtrail"
+}
+
+# Render the CloudFormation template of the customer role for an organization wide StackSet scanned by an Outpost
+data "wiz_aws_iam_policies" "organization" {
+  wiz_principal_arn   = "arn:aws:iam::197171649850:root"
+  external_id         = "00000000-0000-0000-0000-000000000000"
+  deployment_type     = "OUTPOST"
+  outpost_account_id  = "100000000009"
+  outpost_external_id = "11111111-1111-1111-1111-111111111111"
+  organization_wide   = true
+}
+
+resource "aws_cloudformation_stack_set" "wiz" {
+  name             = "wiz-access"
+  permission_model = "SERVICE_MANAGED"
+  capabilities     = ["CAPABILITY_NAMED_IAM"]
+  template_body    = data.wiz_aws_iam_policies.organization.cloudformation_template_json
+
+  auto_deployment {
+    enabled = true
+  }
+}
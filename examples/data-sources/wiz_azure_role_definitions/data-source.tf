@@ -0,0 +1,57 @@
+# Create the Wiz application and grant it access to a subscription and Entra ID
+data "wiz_azure_role_definitions" "example" {
+  data_scanning_enabled = true
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azuread_application" "wiz" {
+  display_name = "Wiz"
+
+  required_resource_access {
+    resource_app_id = data.wiz_azure_role_definitions.example.graph_app_id
+
+    dynamic "resource_access" {
+      for_each = data.wiz_azure_role_definitions.example.graph_api_permissions
+      content {
+        id   = resource_access.value.id
+        type = "Role"
+      }
+    }
+  }
+}
+
+resource "azuread_service_principal" "wiz" {
+  client_id = azuread_application.wiz.client_id
+}
+
+resource "azurerm_role_definition" "wiz" {
+  for_each = { for definition in data.wiz_azure_role_definitions.example.role_definitions : definition.name => definition }
+
+  name        = each.value.name
+  description = each.value.description
+  scope       = data.azurerm_subscription.current.id
+
+  permissions {
+    actions      = each.value.actions
+    data_actions = each.value.data_actions
+  }
+
+  assignable_scopes = [data.azurerm_subscription.current.id]
+}
+
+resource "azurerm_role_assignment" "wiz_custom" {
+  for_each = azurerm_role_definition.wiz
+
+  scope              = data.azurerm_subscription.current.id
+  role_definition_id = each.value.role_definition_resource_id
+  principal_id       = azuread_service_principal.wiz.object_id
+}
+
+resource "azurerm_role_assignment" "wiz_built_in" {
+  for_each = toset(data.wiz_azure_role_definitions.example.built_in_roles)
+
+  scope                = data.azurerm_subscription.current.id
+  role_definition_name = each.value
+  principal_id         = azuread_service_principal.wiz.object_id
+}
@@ -0,0 +1,62 @@
+# Grant the Wiz service account access to a GCP organization, with Cloud Events
+data "wiz_gcp_iam_permissions" "example" {
+  wiz_service_account_email = "wiz-scanner@wiz-project.iam.gserviceaccount.com"
+  scope                     = "ORGANIZATION"
+  audit_log_monitor_enabled = true
+}
+
+resource "google_organization_iam_custom_role" "wiz" {
+  org_id      = "123456789012"
+  role_id     = "wizCustomRole"
+  title       = "Wiz Custom Role"
+  permissions = data.wiz_gcp_iam_permissions.example.custom_role_permissions
+}
+
+resource "google_organization_iam_member" "wiz" {
+  for_each = { for binding in data.wiz_gcp_iam_permissions.example.bindings : binding.role => binding }
+
+  org_id = "123456789012"
+  role   = each.value.role
+  member = each.value.member
+}
+
+resource "google_organization_iam_member" "wiz_custom_role" {
+  org_id = "123456789012"
+  role   = google_organization_iam_custom_role.wiz.name
+  member = data.wiz_gcp_iam_permissions.example.member
+}
+
+resource "google_project_service" "wiz" {
+  for_each = toset(data.wiz_gcp_iam_permissions.example.required_services)
+
+  project = "example-project"
+  service = each.value
+}
+
+resource "google_pubsub_topic" "wiz" {
+  project = "example-project"
+  name    = "wiz-audit-logs"
+}
+
+resource "google_logging_organization_sink" "wiz" {
+  org_id           = "123456789012"
+  name             = "wiz-audit-logs"
+  destination      = "pubsub.googleapis.com/${google_pubsub_topic.wiz.id}"
+  filter           = data.wiz_gcp_iam_permissions.example.audit_log_sink_filter
+  include_children = true
+}
+
+resource "google_pubsub_subscription" "wiz" {
+  project = "example-project"
+  name    = "wiz-audit-logs"
+  topic   = google_pubsub_topic.wiz.id
+}
+
+resource "google_pubsub_subscription_iam_member" "wiz" {
+  for_each = toset(data.wiz_gcp_iam_permissions.example.pubsub_subscription_roles)
+
+  project      = "example-project"
+  subscription = google_pubsub_subscription.wiz.name
+  role         = each.value
+  member       = data.wiz_gcp_iam_permissions.example.member
+}
@@ -0,0 +1 @@
+terraform import wiz_automation_rule.example "d3d2a6f8-1f4e-4a38-8a3b-9d1c5a6a4b2e"
@@ -0,0 +1,91 @@
+# Provision the integrations used by the automation rule actions
+resource "wiz_integration_aws_sns" "example" {
+  name                      = "example"
+  aws_sns_topic_arn         = "arn:aws:sns:us-east-1:123456789012:Example"
+  aws_sns_access_method     = "ASSUME_SPECIFIED_ROLE"
+  aws_sns_customer_role_arn = "arn:aws:iam::123456789012:role/Example-Role"
+  scope                     = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_integration_jira" "example" {
+  name          = "example"
+  jira_url      = var.jira_url
+  jira_username = var.jira_username
+  jira_password = var.jira_password
+  scope         = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Provision an automation rule that publishes to AWS SNS and creates a Jira ticket
+resource "wiz_automation_rule" "example" {
+  name           = "example"
+  description    = "example description"
+  enabled        = true
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+
+  action {
+    integration_id = wiz_integration_aws_sns.example.id
+    aws_sns {
+      body = jsonencode({
+        "issue" : {
+          "id" : "{{issue.id}}",
+          "severity" : "{{issue.severity}}",
+        }
+      })
+    }
+  }
+
+  action {
+    integration_id = wiz_integration_jira.example.id
+    jira_create_ticket {
+      project    = "PRJ"
+      issue_type = "Bug"
+      labels = [
+        "wiz",
+      ]
+    }
+  }
+}
+
+# Provision an automation rule that publishes critical AWS configuration findings to AWS SNS
+resource "wiz_automation_rule" "configuration_findings" {
+  name           = "example-configuration-findings"
+  enabled        = true
+  trigger_source = "CONFIGURATION_FINDING"
+  trigger_type = [
+    "CREATED",
+  ]
+
+  filter {
+    configuration_finding {
+      severity = [
+        "CRITICAL",
+      ]
+      rule {
+        cloud_provider = [
+          "AWS",
+        ]
+      }
+    }
+  }
+
+  action {
+    integration_id = wiz_integration_aws_sns.example.id
+    aws_sns {
+      body = jsonencode({
+        "finding" : {
+          "id" : "{{configurationFinding.id}}",
+        }
+      })
+    }
+  }
+}
@@ -69,3 +69,35 @@ resource "wiz_automation_rule_aws_sns" "example" {
     ]
   })
 }
+
+# Provision an AWS SNS automation rule using a structured filter
+resource "wiz_automation_rule_aws_sns" "structured" {
+  name           = "example-structured"
+  description    = "example description"
+  enabled        = true
+  integration_id = wiz_integration_aws_sns.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  aws_sns_body = jsonencode({
+    "issue" : {
+      "id" : "{{issue.id}}",
+      "severity" : "{{issue.severity}}"
+    }
+  })
+  filter {
+    severity = [
+      "CRITICAL",
+      "HIGH",
+    ]
+    status = [
+      "OPEN",
+    ]
+    related_entity {
+      cloud_platform = [
+        "AWS",
+      ]
+    }
+  }
+}
@@ -0,0 +1,35 @@
+# Publish a message to Azure Service Bus for new critical issues
+resource "wiz_automation_rule_azure_service_bus" "example" {
+  name           = "example"
+  description    = "example description"
+  enabled        = true
+  integration_id = wiz_integration_azure_service_bus.connector_credentials.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  azure_service_bus_body = jsonencode({
+    "trigger" : {
+      "source" : "{{triggerSource}}",
+      "type" : "{{triggerType}}",
+      "ruleId" : "{{ruleId}}",
+      "ruleName" : "{{ruleName}}"
+    },
+    "issue" : {
+      "id" : "{{issue.id}}",
+      "status" : "{{issue.status}}",
+      "severity" : "{{issue.severity}}",
+      "created" : "{{issue.createdAt}}"
+    },
+    "control" : {
+      "id" : "{{issue.control.id}}",
+      "name" : "{{issue.control.name}}"
+    }
+  })
+}
@@ -0,0 +1,28 @@
+# Provision a ClickUp integration
+resource "wiz_integration_clickup" "example" {
+  name            = "example"
+  clickup_api_key = var.clickup_api_key
+  scope           = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Create an urgent ClickUp task for critical issues
+resource "wiz_automation_rule_clickup_create_task" "example" {
+  name           = "example"
+  description    = "Create ClickUp tasks for critical issues"
+  enabled        = true
+  integration_id = wiz_integration_clickup.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  clickup_list_id  = "901234567"
+  clickup_priority = 1
+  clickup_tags = [
+    "wiz",
+  ]
+}
@@ -0,0 +1,25 @@
+# Email the security team about new critical issues, with the evidence attached as a CSV
+resource "wiz_automation_rule_email" "example" {
+  name           = "example"
+  description    = "Email critical issues"
+  enabled        = true
+  integration_id = "3f1b5d7a-9c2e-4a6b-8d0f-2e4c6a8b0d1f"
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  email_to = [
+    "security@example.com",
+  ]
+  email_cc = [
+    "cloud-platform@example.com",
+  ]
+  email_note                = "Please triage {{issue.control.name}} within 24 hours."
+  email_attach_evidence_csv = true
+}
@@ -0,0 +1,35 @@
+# Publish a message to GCP Pub/Sub for new critical issues
+resource "wiz_automation_rule_gcp_pub_sub" "example" {
+  name           = "example"
+  description    = "example description"
+  enabled        = true
+  integration_id = wiz_integration_gcp_pub_sub.connector_credentials.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  gcp_pub_sub_body = jsonencode({
+    "trigger" : {
+      "source" : "{{triggerSource}}",
+      "type" : "{{triggerType}}",
+      "ruleId" : "{{ruleId}}",
+      "ruleName" : "{{ruleName}}"
+    },
+    "issue" : {
+      "id" : "{{issue.id}}",
+      "status" : "{{issue.status}}",
+      "severity" : "{{issue.severity}}",
+      "created" : "{{issue.createdAt}}"
+    },
+    "control" : {
+      "id" : "{{issue.control.id}}",
+      "name" : "{{issue.control.name}}"
+    }
+  })
+}
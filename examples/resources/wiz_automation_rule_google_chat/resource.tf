@@ -0,0 +1,17 @@
+# Post new critical issues to a Google Chat space
+resource "wiz_automation_rule_google_chat" "example" {
+  name           = "example"
+  description    = "Notify Google Chat about critical issues"
+  enabled        = true
+  integration_id = "ab48ad5e-44fb-48f8-9899-24ee4ed974c1"
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  google_chat_note = "New critical issue: {{issue.control.name}}"
+}
@@ -0,0 +1,24 @@
+# Provision an Opsgenie integration
+resource "wiz_integration_opsgenie" "example" {
+  name             = "example"
+  opsgenie_api_key = var.opsgenie_api_key
+  scope            = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Close the alert opened by wiz_automation_rule_opsgenie_create_alert when the issue is resolved
+resource "wiz_automation_rule_opsgenie_close_alert" "example" {
+  name           = "example"
+  description    = "Close alerts for resolved issues"
+  enabled        = true
+  integration_id = wiz_integration_opsgenie.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "RESOLVED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  opsgenie_note = "Resolved in Wiz: {{issue.resolutionReason}}"
+}
@@ -0,0 +1,64 @@
+# Provision an Opsgenie integration
+resource "wiz_integration_opsgenie" "example" {
+  name             = "example"
+  opsgenie_api_key = var.opsgenie_api_key
+  scope            = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Open a P1 alert for critical issues, the priority is mapped from the severity filter
+resource "wiz_automation_rule_opsgenie_create_alert" "critical" {
+  name           = "critical"
+  description    = "Open alerts for critical issues"
+  enabled        = true
+  integration_id = wiz_integration_opsgenie.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  opsgenie_tags = [
+    "wiz",
+  ]
+}
+
+# Open alerts for high and medium issues with a custom priority mapping
+resource "wiz_automation_rule_opsgenie_create_alert" "high" {
+  name           = "high"
+  description    = "Open alerts for high and medium issues"
+  enabled        = true
+  integration_id = wiz_integration_opsgenie.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filter {
+    severity = [
+      "HIGH",
+      "MEDIUM",
+    ]
+  }
+  opsgenie_message = "{{issue.severity}}: {{issue.control.name}}"
+  opsgenie_priority_mapping = {
+    "HIGH" = "P1"
+  }
+}
+
+# Open P2 alerts for every issue, overriding the priority mapping
+resource "wiz_automation_rule_opsgenie_create_alert" "all" {
+  name           = "all"
+  description    = "Open alerts for all issues"
+  enabled        = true
+  integration_id = wiz_integration_opsgenie.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filters           = jsonencode({})
+  opsgenie_priority = "P2"
+}
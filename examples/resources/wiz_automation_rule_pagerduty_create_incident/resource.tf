@@ -0,0 +1,33 @@
+# Provision a PagerDuty integration
+resource "wiz_integration_pagerduty" "example" {
+  name                  = "example"
+  pagerduty_routing_key = var.pagerduty_routing_key
+  scope                 = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Page the on-call engineer when a critical issue is created
+resource "wiz_automation_rule_pagerduty_create_incident" "example" {
+  name           = "example"
+  description    = "example description"
+  enabled        = true
+  integration_id = wiz_integration_pagerduty.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  pagerduty_payload = jsonencode({
+    "summary" : "{{issue.control.name}}",
+    "severity" : "critical",
+    "source" : "{{issue.entitySnapshot.name}}",
+    "custom_details" : {
+      "issueId" : "{{issue.id}}",
+      "projects" : "{{#issue.projects}}{{name}}, {{/issue.projects}}"
+    }
+  })
+}
@@ -0,0 +1,23 @@
+# Provision a PagerDuty integration
+resource "wiz_integration_pagerduty" "example" {
+  name                  = "example"
+  pagerduty_routing_key = var.pagerduty_routing_key
+  scope                 = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Resolve the PagerDuty incident when the issue is resolved
+resource "wiz_automation_rule_pagerduty_resolve_incident" "example" {
+  name           = "example"
+  description    = "example description"
+  enabled        = true
+  integration_id = wiz_integration_pagerduty.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "RESOLVED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+}
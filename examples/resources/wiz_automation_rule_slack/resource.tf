@@ -0,0 +1,25 @@
+# Provision a Slack integration
+resource "wiz_integration_slack" "example" {
+  name      = "example"
+  slack_url = var.slack_webhook_url
+  scope     = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Provision a Slack automation rule
+resource "wiz_automation_rule_slack" "example" {
+  name           = "example"
+  description    = "example description"
+  enabled        = true
+  integration_id = wiz_integration_slack.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  slack_note = "{{issue.severity}} issue {{issue.control.name}} on {{issue.entitySnapshot.name}}"
+}
@@ -0,0 +1,26 @@
+# Provision a Slack bot integration
+resource "wiz_integration_slack_bot" "example" {
+  name        = "example"
+  slack_token = var.slack_bot_token
+  scope       = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Provision a Slack bot automation rule
+resource "wiz_automation_rule_slack_bot" "example" {
+  name           = "example"
+  description    = "example description"
+  enabled        = true
+  integration_id = wiz_integration_slack_bot.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  slack_bot_channel = "#security-alerts"
+  slack_bot_note    = "{{issue.severity}} issue {{issue.control.name}} on {{issue.entitySnapshot.name}}"
+}
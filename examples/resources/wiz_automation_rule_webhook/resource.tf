@@ -0,0 +1,45 @@
+# Provision a webhook integration
+resource "wiz_integration_webhook" "example" {
+  name          = "example"
+  webhook_url   = "https://soar.example.com/hooks/wiz"
+  webhook_token = var.soar_token
+  scope         = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Provision a webhook automation rule
+resource "wiz_automation_rule_webhook" "example" {
+  name           = "example"
+  description    = "example description"
+  enabled        = true
+  integration_id = wiz_integration_webhook.example.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  webhook_body = jsonencode({
+    "trigger" : {
+      "source" : "{{triggerSource}}",
+      "type" : "{{triggerType}}",
+      "ruleId" : "{{ruleId}}",
+      "ruleName" : "{{ruleName}}"
+    },
+    "issue" : {
+      "id" : "{{issue.id}}",
+      "status" : "{{issue.status}}",
+      "severity" : "{{issue.severity}}",
+      "created" : "{{issue.createdAt}}",
+      "projects" : "{{#issue.projects}}{{name}}, {{/issue.projects}}"
+    }
+  })
+
+  webhook_header {
+    key   = "X-Source"
+    value = "wiz"
+  }
+}
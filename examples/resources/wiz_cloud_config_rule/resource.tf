@@ -53,3 +53,23 @@ hasAccessKeyRotationRule(configRule) {
 EOT
   }
 }
+
+# Scope the rule by AWS account ID rather than Wiz identifier
+resource "wiz_cloud_config_rule" "external_ids" {
+  name        = "terraform-test-external-ids"
+  description = "test description"
+  target_native_types = [
+    "account",
+  ]
+  scope_account_external_ids = [
+    "100000000009",
+    "100000000010",
+  ]
+  remediation_instructions = "fix it"
+  severity                 = "HIGH"
+  opa_policy               = <<EOT
+package wiz
+
+default result = "pass"
+EOT
+}
@@ -0,0 +1,8 @@
+# Importing Considerations:
+#
+# Please note this is considered experimental, exercise caution and consider the following:
+#
+# - Wiz does not return credentials, make sure that `client_secret` is set to the value used when the connector was created outside of Terraform.
+#   Once set in state, any further changes to the credentials or the registry require a resource recreation.
+#
+terraform import wiz_connector_acr.import_example "7be792ba-bfd1-46d0-9fba-5f6bc19df4a8"
@@ -0,0 +1,12 @@
+# Connect an ACR registry using a service principal with the AcrPull role
+resource "wiz_connector_acr" "example" {
+  name          = "example"
+  registry_url  = "example.azurecr.io"
+  tenant_id     = "00000000-0000-0000-0000-000000000000"
+  client_id     = "11111111-1111-1111-1111-111111111111"
+  client_secret = var.acr_client_secret
+
+  excluded_repositories = [
+    "sandbox/*",
+  ]
+}
@@ -0,0 +1,17 @@
+# Provision an Alibaba Cloud connector for a resource directory using a RAM user AccessKey
+resource "wiz_connector_alibaba" "example" {
+  name = "example"
+  auth_params = jsonencode({
+    "accountId" : "5123456789012345",
+    "resourceDirectoryId" : "rd-example",
+    "accessKeyId" : "LTAI5tExampleAccessKeyId",
+    "accessKeySecret" : var.alibaba_access_key_secret
+  })
+
+  extra_config = jsonencode(
+    {
+      "excludedAccounts" : [],
+      "diskAnalyzerInFlightDisabled" : false
+    }
+  )
+}
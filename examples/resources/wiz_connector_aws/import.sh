@@ -7,6 +7,9 @@
 #
 # - For `auth_params` include `customerRoleArn`. If using outposts, also include `outPostId` and `diskAnalyzer` structure.
 #
+# - When using the `customer_role` and `outpost` blocks instead of `auth_params`, the first `terraform apply` after the import
+#   updates the connector in place with the configured values.
+#
 # For more information, refer to the examples in the documentation.
 #
 terraform import wiz_connector_aws.import_example "7be792ba-bfd1-46d0-9fba-5f6bc19df4a8"
@@ -20,15 +20,18 @@ resource "wiz_connector_aws" "example" {
 # Provision an AWS connector with Outpost that uses a custom config
 resource "wiz_connector_aws" "example" {
   name = "example"
-  auth_params = jsonencode({
-    "customerRoleARN" : "arn:aws:iam::100000000009:role/wiz-customer",
-    "outpostId" : "078862d0-a62f-406c-b966-13445af34c0d",
-    "diskAnalyzer" : {
-      "scanner" : {
-        "roleARN" : "arn:aws:iam::100000000009:role/outpost-scanner"
-      }
-    },
-  })
+
+  customer_role {
+    role_arn = "arn:aws:iam::100000000009:role/wiz-customer"
+  }
+
+  outpost {
+    outpost_id = "078862d0-a62f-406c-b966-13445af34c0d"
+
+    scanner {
+      role_arn = "arn:aws:iam::100000000009:role/outpost-scanner"
+    }
+  }
 
   extra_config = jsonencode(
     {
@@ -48,3 +51,35 @@ resource "wiz_connector_aws" "example" {
   )
 
 }
+
+# Provision an AWS connector and wait for it to connect before dependent resources are created
+resource "wiz_connector_aws" "example" {
+  name = "example"
+
+  customer_role {
+    role_arn = "arn:aws:iam::100000000009:role/wiz-customer"
+  }
+
+  wait_for_status = ["CONNECTED", "PARTIALLY_CONNECTED"]
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+# Provision an AWS connector with structured extra configuration, removed settings are reset and drift is reported
+resource "wiz_connector_aws" "example" {
+  name = "example"
+
+  customer_role {
+    role_arn = "arn:aws:iam::100000000009:role/wiz-customer"
+  }
+
+  opted_in_regions          = ["us-east-1", "us-west-2"]
+  excluded_accounts         = ["100000000010"]
+  audit_log_monitor_enabled = true
+
+  events_cloudtrail_bucket_name        = "buckethere"
+  events_cloudtrail_bucket_sub_account = "000000000012"
+  events_cloudtrail_organization       = "o-myorg"
+}
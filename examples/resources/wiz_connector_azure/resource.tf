@@ -0,0 +1,36 @@
+# Provision an Azure connector scoped to the tenant root management group
+resource "wiz_connector_azure" "tenant" {
+  name                = "example-tenant"
+  tenant_id           = "8e4f5a2d-1b3c-4d6e-9f0a-7b8c9d0e1f2a"
+  management_group_id = "8e4f5a2d-1b3c-4d6e-9f0a-7b8c9d0e1f2a"
+  auth_params = jsonencode({
+    "isManagedIdentity" : true
+  })
+
+  excluded_subscriptions = [
+    "4b2a8c6d-0e1f-4a3b-8c5d-6e7f8a9b0c1d"
+  ]
+  excluded_management_groups = [
+    "mg-sandbox"
+  ]
+  audit_log_monitor_enabled = false
+}
+
+# Provision an Azure connector targeting an individual subscription, scanned by an outpost
+resource "wiz_connector_azure" "subscription" {
+  name            = "example-subscription"
+  tenant_id       = "8e4f5a2d-1b3c-4d6e-9f0a-7b8c9d0e1f2a"
+  subscription_id = "2c4e6a8b-1d3f-4b5d-9e7f-0a2c4e6a8b1d"
+  auth_params = jsonencode({
+    "isManagedIdentity" : false,
+    "outPostId" : "a8a5c8e4-2b6f-4d3e-9c1a-5f7b9d1e3c2a",
+    "diskAnalyzer" : {
+      "scanner" : {
+        "clientId" : "0f9e8d7c-6b5a-4f3e-2d1c-0b9a8f7e6d5c",
+        "clientSecret" : var.azure_scanner_client_secret
+      }
+    }
+  })
+
+  disk_analyzer_inflight_disabled = true
+}
@@ -0,0 +1,12 @@
+# Connect an Azure DevOps organization, scanning pull requests and commenting on findings
+resource "wiz_connector_azure_devops" "example" {
+  name                  = "example"
+  organization          = "example-org"
+  personal_access_token = var.azure_devops_token
+
+  included_repositories = [
+    "payments-api",
+  ]
+  pull_request_scanning_enabled = true
+  pull_request_comments_enabled = true
+}
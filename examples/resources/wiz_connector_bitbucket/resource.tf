@@ -0,0 +1,18 @@
+# Connect a Bitbucket Cloud workspace using an app password
+resource "wiz_connector_bitbucket" "example" {
+  name         = "example"
+  workspace    = "example-workspace"
+  username     = "wiz-scanner"
+  app_password = var.bitbucket_app_password
+
+  pull_request_scanning_enabled = true
+  pull_request_comments_enabled = true
+}
+
+# Connect a Bitbucket Data Center project using an HTTP access token
+resource "wiz_connector_bitbucket" "data_center" {
+  name         = "example-data-center"
+  url          = "https://bitbucket.example.com"
+  workspace    = "PLAT"
+  access_token = var.bitbucket_access_token
+}
@@ -0,0 +1,12 @@
+# Connect a Docker Hub organization using an organization access token
+resource "wiz_connector_docker_hub" "example" {
+  name         = "example"
+  namespace    = "example-org"
+  username     = "example-org"
+  access_token = var.docker_hub_token
+
+  included_repositories = [
+    "api",
+    "web",
+  ]
+}
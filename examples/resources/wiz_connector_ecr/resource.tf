@@ -0,0 +1,12 @@
+# Connect an ECR registry in an AWS account that is not onboarded to Wiz using a cross-account role
+resource "wiz_connector_ecr" "example" {
+  name         = "example"
+  registry_url = "123456789012.dkr.ecr.us-east-1.amazonaws.com"
+  role_arn     = "arn:aws:iam::123456789012:role/WizECRAccess"
+  external_id  = "b5f2c6a4-5a7b-4e1c-9d1e-2f3a4b5c6d7e"
+
+  included_repositories = [
+    "payments/*",
+  ]
+  scan_frequency = "HOURLY"
+}
@@ -37,3 +37,32 @@ resource "wiz_connector_gcp" "example" {
     }
   )
 }
+
+# Provision a GCP connector and wait for it to connect before dependent resources are created
+resource "wiz_connector_gcp" "example" {
+  name = "example"
+  auth_params = jsonencode({
+    "isManagedIdentity" : true,
+    "project_id" : "example-project-id"
+  })
+
+  wait_for_status = ["CONNECTED"]
+
+  timeouts {
+    create = "30m"
+    update = "30m"
+  }
+}
+
+# Provision a GCP connector with structured extra configuration, removed settings are reset and drift is reported
+resource "wiz_connector_gcp" "example" {
+  name = "example"
+  auth_params = jsonencode({
+    "isManagedIdentity" : true,
+    "organization_id" : "o-example"
+  })
+
+  excluded_projects         = ["example-sandbox-project"]
+  excluded_folders          = ["folders/1234567890"]
+  audit_log_monitor_enabled = true
+}
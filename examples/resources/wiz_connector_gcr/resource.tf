@@ -0,0 +1,8 @@
+# Connect an Artifact Registry repository using a service account key
+resource "wiz_connector_gcr" "example" {
+  name                = "example"
+  registry_url        = "us-docker.pkg.dev/example-project/images"
+  service_account_key = file("${path.module}/wiz-artifact-registry-reader.json")
+
+  scan_frequency = "WEEKLY"
+}
@@ -0,0 +1,27 @@
+# Connect a GitHub organization using a GitHub App, scanning pull requests and commenting on findings
+resource "wiz_connector_github" "example" {
+  name                = "example"
+  organization        = "example-org"
+  app_id              = "123456"
+  app_installation_id = "45678901"
+  app_private_key     = file("${path.module}/wiz-github-app.pem")
+
+  excluded_repositories = [
+    "archived-monolith",
+  ]
+  pull_request_scanning_enabled = true
+  pull_request_comments_enabled = true
+}
+
+# Connect a GitHub Enterprise Server organization using a personal access token
+resource "wiz_connector_github" "enterprise" {
+  name                  = "example-enterprise"
+  url                   = "https://github.example.com"
+  organization          = "platform"
+  personal_access_token = var.github_token
+
+  included_repositories = [
+    "payments-api",
+    "payments-web",
+  ]
+}
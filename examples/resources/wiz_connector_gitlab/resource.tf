@@ -0,0 +1,12 @@
+# Connect a GitLab group, scanning pull requests and commenting on findings
+resource "wiz_connector_gitlab" "example" {
+  name         = "example"
+  group        = "example-group/platform"
+  access_token = var.gitlab_token
+
+  excluded_repositories = [
+    "sandbox",
+  ]
+  pull_request_scanning_enabled = true
+  pull_request_comments_enabled = true
+}
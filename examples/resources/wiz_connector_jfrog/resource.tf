@@ -0,0 +1,12 @@
+# Connect JFrog Artifactory Docker repositories using an access token
+resource "wiz_connector_jfrog" "example" {
+  name         = "example"
+  registry_url = "https://example.jfrog.io"
+  username     = "wiz-scanner"
+  access_token = var.jfrog_token
+
+  excluded_repositories = [
+    "docker-remote/*",
+  ]
+  scan_frequency = "DAILY"
+}
@@ -0,0 +1,38 @@
+# Register an on-premises cluster reached through the Wiz broker and create its broker service account
+resource "wiz_connector_kubernetes" "example" {
+  name                 = "example"
+  service_account_type = "BROKER"
+  auth_params = jsonencode({
+    "isOnPrem" : true,
+    "serverEndpoint" : "https://kubernetes.default.svc.cluster.local"
+  })
+}
+
+# Deploy the Wiz Kubernetes integration with the generated connector and credentials
+resource "helm_release" "wiz_kubernetes_integration" {
+  name             = "wiz-kubernetes-integration"
+  repository       = "https://charts.wiz.io"
+  chart            = "wiz-kubernetes-integration"
+  namespace        = "wiz"
+  create_namespace = true
+
+  set {
+    name  = "wiz-kubernetes-connector.autoCreateConnector.enabled"
+    value = "false"
+  }
+
+  set {
+    name  = "wiz-kubernetes-connector.wizConnector.connectorId"
+    value = wiz_connector_kubernetes.example.id
+  }
+
+  set_sensitive {
+    name  = "global.wizApiToken.clientId"
+    value = wiz_connector_kubernetes.example.client_id
+  }
+
+  set_sensitive {
+    name  = "global.wizApiToken.clientToken"
+    value = wiz_connector_kubernetes.example.client_secret
+  }
+}
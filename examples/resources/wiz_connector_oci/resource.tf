@@ -0,0 +1,20 @@
+# Provision an OCI connector for a tenancy using an API signing key
+resource "wiz_connector_oci" "example" {
+  name = "example"
+  auth_params = jsonencode({
+    "tenancyOCID" : "ocid1.tenancy.oc1..aaaaaaaaexampletenancy",
+    "userOCID" : "ocid1.user.oc1..aaaaaaaaexampleuser",
+    "fingerprint" : "12:34:56:78:9a:bc:de:f0:12:34:56:78:9a:bc:de:f0",
+    "privateKey" : file("${path.module}/wiz-oci-api-key.pem"),
+    "homeRegion" : "us-ashburn-1"
+  })
+
+  extra_config = jsonencode(
+    {
+      "excludedCompartments" : [
+        "ocid1.compartment.oc1..aaaaaaaaexamplesandbox"
+      ],
+      "diskAnalyzerInFlightDisabled" : false
+    }
+  )
+}
@@ -0,0 +1 @@
+terraform import wiz_host_config_rule.ssh_root_login "cdd2c255-921d-4ea9-b348-5660a7b9d459"
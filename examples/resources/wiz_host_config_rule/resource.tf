@@ -0,0 +1,13 @@
+# Provision a custom host configuration rule from an OVAL definition
+resource "wiz_host_config_rule" "ssh_root_login" {
+  name        = "Ensure SSH root login is disabled"
+  description = "PermitRootLogin must be set to no in /etc/ssh/sshd_config."
+  enabled     = true
+  direct_oval = file("${path.module}/ssh_root_login.xml")
+  target_platform_ids = [
+    "b488610a-6846-404c-8e5b-28ee04846dda",
+  ]
+  security_sub_categories = [
+    "wsct-id-5206",
+  ]
+}
@@ -0,0 +1 @@
+terraform import wiz_integration_azure_service_bus.connector_credentials "4d2a6c8e-0f1b-4c3d-9e5f-7a9b1c3d5e7f"
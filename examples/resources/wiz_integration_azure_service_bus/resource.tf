@@ -0,0 +1,16 @@
+# Provision an Azure Service Bus integration using the credentials of an Azure connector
+resource "wiz_integration_azure_service_bus" "connector_credentials" {
+  name                            = "connector-credentials"
+  azure_service_bus_queue_url     = "https://example.servicebus.windows.net/wiz-issues"
+  azure_service_bus_access_method = "CONNECTOR_CREDENTIALS"
+  azure_service_bus_connector_id  = "ab48ad5e-44fb-48f8-9899-24ee4ed974c1"
+}
+
+# Provision an Azure Service Bus integration using a connection string with a shared access signature
+resource "wiz_integration_azure_service_bus" "connection_string" {
+  name                                         = "connection-string"
+  azure_service_bus_queue_url                  = "https://example.servicebus.windows.net/wiz-issues"
+  azure_service_bus_access_method              = "CONNECTION_STRING_WITH_SAS"
+  azure_service_bus_connection_string_with_sas = var.azure_service_bus_connection_string
+  scope                                        = "All Resources, Restrict this Integration to global roles only"
+}
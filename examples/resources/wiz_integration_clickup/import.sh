@@ -0,0 +1 @@
+terraform import wiz_integration_clickup.default "3f1b5d7a-9c2e-4a6b-8d0f-2e4c6a8b0d1f"
@@ -0,0 +1,5 @@
+resource "wiz_integration_clickup" "default" {
+  name            = "default"
+  clickup_api_key = var.clickup_api_key
+  scope           = "All Resources, Restrict this Integration to global roles only"
+}
@@ -0,0 +1 @@
+terraform import wiz_integration_gcp_pub_sub.connector_credentials "8b0d2f4a-6c8e-4a1b-b3d5-9f1a3c5e7b9d"
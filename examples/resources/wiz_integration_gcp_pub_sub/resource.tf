@@ -0,0 +1,18 @@
+# Provision a GCP Pub/Sub integration using the service account of a GCP connector
+resource "wiz_integration_gcp_pub_sub" "connector_credentials" {
+  name                      = "connector-credentials"
+  gcp_pub_sub_project_id    = "example-project"
+  gcp_pub_sub_topic_id      = "wiz-issues"
+  gcp_pub_sub_access_method = "CONNECTOR_CREDENTIALS"
+  gcp_pub_sub_connector_id  = "ab48ad5e-44fb-48f8-9899-24ee4ed974c1"
+}
+
+# Provision a GCP Pub/Sub integration using a service account key
+resource "wiz_integration_gcp_pub_sub" "service_account_key" {
+  name                            = "service-account-key"
+  gcp_pub_sub_project_id          = "example-project"
+  gcp_pub_sub_topic_id            = "wiz-issues"
+  gcp_pub_sub_access_method       = "SERVICE_ACCOUNT_KEY"
+  gcp_pub_sub_service_account_key = file("${path.module}/service-account-key.json")
+  scope                           = "All Resources, Restrict this Integration to global roles only"
+}
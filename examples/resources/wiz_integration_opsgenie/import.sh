@@ -0,0 +1 @@
+terraform import wiz_integration_opsgenie.default "6a8c0e2f-4b6d-4f8a-9c1e-3b5d7f9a1c3e"
@@ -0,0 +1,5 @@
+resource "wiz_integration_opsgenie" "default" {
+  name             = "default"
+  opsgenie_api_key = var.opsgenie_api_key
+  scope            = "All Resources, Restrict this Integration to global roles only"
+}
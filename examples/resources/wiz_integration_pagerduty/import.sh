@@ -0,0 +1 @@
+terraform import wiz_integration_pagerduty.default "b1d3c5e7-2a4f-4e6b-8d0a-1c3e5f7a9b2d"
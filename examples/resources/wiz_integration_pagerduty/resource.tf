@@ -0,0 +1,5 @@
+resource "wiz_integration_pagerduty" "default" {
+  name                  = "default"
+  pagerduty_routing_key = var.pagerduty_routing_key
+  scope                 = "All Resources, Restrict this Integration to global roles only"
+}
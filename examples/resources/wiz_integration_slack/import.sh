@@ -0,0 +1 @@
+terraform import wiz_integration_slack.default "3a34bd70-3e07-4a0b-8d55-4e4f0e5ad0f6"
@@ -0,0 +1,5 @@
+resource "wiz_integration_slack" "default" {
+  name      = "default"
+  slack_url = var.slack_webhook_url
+  scope     = "All Resources, Restrict this Integration to global roles only"
+}
@@ -0,0 +1 @@
+terraform import wiz_integration_slack_bot.default "0f8b59e0-6b5c-4a0e-9c43-5f5d1f3e1b7a"
@@ -0,0 +1,5 @@
+resource "wiz_integration_slack_bot" "default" {
+  name        = "default"
+  slack_token = var.slack_bot_token
+  scope       = "All Resources, Restrict this Integration to global roles only"
+}
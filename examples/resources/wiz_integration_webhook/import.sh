@@ -0,0 +1 @@
+terraform import wiz_integration_webhook.bearer "5c1a1b2f-8b0d-4f3c-a7a2-0a5f9cbbd1e4"
@@ -0,0 +1,23 @@
+# Provision a webhook integration with bearer authorization
+resource "wiz_integration_webhook" "bearer" {
+  name          = "soar"
+  webhook_url   = "https://soar.example.com/hooks/wiz"
+  webhook_token = var.soar_token
+  scope         = "All Resources, Restrict this Integration to global roles only"
+}
+
+# Provision a webhook integration with basic authorization, custom headers and mutual TLS
+resource "wiz_integration_webhook" "basic" {
+  name                                       = "siem"
+  webhook_url                                = "https://siem.example.com/ingest"
+  webhook_username                           = "wiz"
+  webhook_password                           = var.siem_password
+  webhook_server_ca                          = file("${path.module}/ca.pem")
+  webhook_client_certificate_and_private_key = var.siem_client_pem
+  scope                                      = "All Resources, Restrict this Integration to global roles only"
+
+  webhook_header {
+    key   = "X-Source"
+    value = "wiz"
+  }
+}
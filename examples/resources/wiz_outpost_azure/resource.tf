@@ -0,0 +1,18 @@
+# Provision an Azure Outpost
+resource "wiz_outpost_azure" "example" {
+  name            = "example"
+  tenant_id       = "11111111-1111-1111-1111-111111111111"
+  subscription_id = "22222222-2222-2222-2222-222222222222"
+
+  key_vault_name             = "wiz-outpost-kv"
+  application_key_vault_name = "wiz-outpost-app-kv"
+
+  orchestrator_client_id     = azuread_application.wiz_orchestrator.client_id
+  orchestrator_client_secret = azuread_application_password.wiz_orchestrator.value
+  worker_client_id           = azuread_application.wiz_worker.client_id
+  worker_client_secret       = azuread_application_password.wiz_worker.value
+
+  configuration_storage_account_name = "wizoutpostconfig"
+  global_resource_group_name         = "wiz-outpost-global"
+  enable_private_cluster             = true
+}
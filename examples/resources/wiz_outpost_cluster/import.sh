@@ -0,0 +1,2 @@
+# Outpost clusters are imported using the Outpost ID and the cluster ID separated by a colon
+terraform import wiz_outpost_cluster.import_example "078862d0-a62f-406c-b966-13445af34c0d:7be792ba-bfd1-46d0-9fba-5f6bc19df4a8"
@@ -0,0 +1,17 @@
+# Provision an Outpost cluster with sized node groups behind an HTTP proxy
+resource "wiz_outpost_cluster" "example" {
+  outpost_id = wiz_outpost_gcp.example.id
+  region     = "us-central1"
+
+  node_group {
+    type           = "DISK_SCANNER"
+    min_node_count = 1
+    max_node_count = 20
+  }
+
+  http_proxy {
+    http_proxy_url  = "http://proxy.example.com:3128"
+    https_proxy_url = "http://proxy.example.com:3128"
+    vpc_cidrs       = ["10.0.0.0/16"]
+  }
+}
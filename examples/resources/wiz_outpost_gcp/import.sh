@@ -0,0 +1,8 @@
+# Importing Considerations:
+#
+# Please note this is considered experimental, exercise caution and consider the following:
+#
+# - Wiz does not return secrets, make sure that `orchestrator_key` is set to the value used when the Outpost was created outside of Terraform.
+#   Otherwise the next `terraform apply` updates the Outpost configuration with the value in Terraform.
+#
+terraform import wiz_outpost_gcp.import_example "7be792ba-bfd1-46d0-9fba-5f6bc19df4a8"
@@ -0,0 +1,31 @@
+# Provision a GCP Outpost, the orchestrator key is read from a service account key resource
+resource "wiz_outpost_gcp" "example" {
+  name                      = "example"
+  orchestrator_key          = base64decode(google_service_account_key.wiz_orchestrator.private_key)
+  configuration_bucket_name = "wiz-outpost-config-example"
+  allowed_regions           = ["us-central1", "europe-west1"]
+}
+
+# Provision a self managed GCP Outpost pulling images from a private repository
+resource "wiz_outpost_gcp" "example" {
+  name                      = "example"
+  orchestrator_key          = base64decode(google_service_account_key.wiz_orchestrator.private_key)
+  configuration_bucket_name = "wiz-outpost-config-example"
+  self_managed              = true
+
+  self_managed_config {
+    image_repository = "us-docker.pkg.dev/example-project/wiz"
+  }
+
+  custom_config {
+    namespace_prefix = "wiz-"
+
+    pod_annotations = {
+      "cluster-autoscaler.kubernetes.io/safe-to-evict" = "true"
+    }
+
+    resource_tags = {
+      owner = "security"
+    }
+  }
+}
@@ -0,0 +1,29 @@
+# Provision an OCI Outpost
+resource "wiz_outpost_oci" "example" {
+  name                      = "example"
+  compartment_ocid          = "ocid1.compartment.oc1..aaaaaaaaexample"
+  vault_ocid                = "ocid1.vault.oc1.iad.aaaaaaaaexample"
+  key_ocid                  = "ocid1.key.oc1.iad.aaaaaaaaexample"
+  configuration_bucket_name = "wiz-outpost-config-example"
+
+  orchestrator_fingerprint = "12:34:56:78:90:ab:cd:ef:12:34:56:78:90:ab:cd:ef"
+  orchestrator_private_key = file("~/.oci/wiz_orchestrator.pem")
+}
+
+# Provision an OCI Outpost and wait until it is ready before connecting accounts through it
+resource "wiz_outpost_oci" "example" {
+  name                      = "example"
+  compartment_ocid          = "ocid1.compartment.oc1..aaaaaaaaexample"
+  vault_ocid                = "ocid1.vault.oc1.iad.aaaaaaaaexample"
+  key_ocid                  = "ocid1.key.oc1.iad.aaaaaaaaexample"
+  configuration_bucket_name = "wiz-outpost-config-example"
+
+  orchestrator_fingerprint = "12:34:56:78:90:ab:cd:ef:12:34:56:78:90:ab:cd:ef"
+  orchestrator_private_key = file("~/.oci/wiz_orchestrator.pem")
+
+  wait_for_ready = true
+
+  timeouts {
+    create = "90m"
+  }
+}
@@ -94,3 +94,21 @@ resource "wiz_project" "test" {
     namespaces         = ["kube-system"]
   }
 }
+
+# This resource links cloud accounts by their AWS account ID and Azure subscription ID
+resource "wiz_project" "test" {
+  name        = "My External ID Project"
+  description = "My project description"
+  risk_profile {
+    business_impact = "MBI"
+  }
+  business_unit = "Technology"
+  cloud_account_link {
+    cloud_account_external_id = "100000000009"
+    environment               = "PRODUCTION"
+  }
+  cloud_account_link {
+    cloud_account_external_id = "6f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0"
+    environment               = "STAGING"
+  }
+}
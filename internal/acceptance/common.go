@@ -17,6 +17,46 @@ const (
 	TcServiceNow TestCase = "SERVICE_NOW"
 	// TcJira test case
 	TcJira TestCase = "JIRA"
+	// TcClickUp test case
+	TcClickUp TestCase = "CLICK_UP"
+	// TcEmail test case
+	TcEmail TestCase = "EMAIL"
+	// TcGoogleChat test case
+	TcGoogleChat TestCase = "GOOGLE_CHAT"
+	// TcOpsgenie test case
+	TcOpsgenie TestCase = "OPSGENIE"
+	// TcPagerDuty test case
+	TcPagerDuty TestCase = "PAGER_DUTY"
+	// TcSlack test case
+	TcSlack TestCase = "SLACK"
+	// TcSlackBot test case
+	TcSlackBot TestCase = "SLACK_BOT"
+	// TcConnectorAzure test case
+	TcConnectorAzure TestCase = "CONNECTOR_AZURE"
+	// TcConnectorOCI test case
+	TcConnectorOCI TestCase = "CONNECTOR_OCI"
+	// TcConnectorAlibaba test case
+	TcConnectorAlibaba TestCase = "CONNECTOR_ALIBABA"
+	// TcConnectorKubernetes test case
+	TcConnectorKubernetes TestCase = "CONNECTOR_KUBERNETES"
+	// TcConnectorAzureDevOps test case
+	TcConnectorAzureDevOps TestCase = "CONNECTOR_AZURE_DEVOPS"
+	// TcConnectorBitbucket test case
+	TcConnectorBitbucket TestCase = "CONNECTOR_BITBUCKET"
+	// TcConnectorGitLab test case
+	TcConnectorGitLab TestCase = "CONNECTOR_GITLAB"
+	// TcConnectorECR test case
+	TcConnectorECR TestCase = "CONNECTOR_ECR"
+	// TcConnectorACR test case
+	TcConnectorACR TestCase = "CONNECTOR_ACR"
+	// TcConnectorGCR test case
+	TcConnectorGCR TestCase = "CONNECTOR_GCR"
+	// TcConnectorDockerHub test case
+	TcConnectorDockerHub TestCase = "CONNECTOR_DOCKER_HUB"
+	// TcConnectorJFrog test case
+	TcConnectorJFrog TestCase = "CONNECTOR_JFROG"
+	// TcConnectorGitHub test case
+	TcConnectorGitHub TestCase = "CONNECTOR_GITHUB"
 	// TcSubscriptionResourceGroups test case
 	TcSubscriptionResourceGroups TestCase = "SUBSCRIPTION_RESOURCE_GROUPS"
 	// TcProject test case
@@ -39,6 +39,46 @@ func testAccPreCheck(t *testing.T, tc TestCase) {
 		envVars = append(commonEnvVars, "WIZ_INTEGRATION_SERVICENOW_URL", "WIZ_INTEGRATION_SERVICENOW_USERNAME", "WIZ_INTEGRATION_SERVICENOW_PASSWORD")
 	case TcJira:
 		envVars = append(commonEnvVars, "WIZ_INTEGRATION_JIRA_URL", "WIZ_INTEGRATION_JIRA_USERNAME", "WIZ_INTEGRATION_JIRA_PASSWORD", "WIZ_INTEGRATION_JIRA_PROJECT")
+	case TcClickUp:
+		envVars = append(commonEnvVars, "WIZ_INTEGRATION_CLICKUP_API_KEY", "WIZ_INTEGRATION_CLICKUP_LIST_ID")
+	case TcEmail:
+		envVars = append(commonEnvVars, "WIZ_INTEGRATION_EMAIL_ID", "WIZ_SMTP_DOMAIN")
+	case TcGoogleChat:
+		envVars = append(commonEnvVars, "WIZ_INTEGRATION_GOOGLE_CHAT_ID")
+	case TcOpsgenie:
+		envVars = append(commonEnvVars, "WIZ_INTEGRATION_OPSGENIE_API_KEY")
+	case TcPagerDuty:
+		envVars = append(commonEnvVars, "WIZ_INTEGRATION_PAGERDUTY_ROUTING_KEY")
+	case TcSlack:
+		envVars = append(commonEnvVars, "WIZ_INTEGRATION_SLACK_URL")
+	case TcSlackBot:
+		envVars = append(commonEnvVars, "WIZ_INTEGRATION_SLACK_BOT_TOKEN", "WIZ_INTEGRATION_SLACK_BOT_CHANNEL")
+	case TcConnectorAzure:
+		envVars = append(commonEnvVars, "WIZ_AZURE_TENANT_ID", "WIZ_SUBSCRIPTION_ID")
+	case TcConnectorOCI:
+		envVars = append(commonEnvVars, "WIZ_OCI_TENANCY_OCID", "WIZ_OCI_USER_OCID", "WIZ_OCI_FINGERPRINT", "WIZ_OCI_PRIVATE_KEY", "WIZ_OCI_HOME_REGION")
+	case TcConnectorAlibaba:
+		envVars = append(commonEnvVars, "WIZ_ALIBABA_ACCOUNT_ID", "WIZ_ALIBABA_ACCESS_KEY_ID", "WIZ_ALIBABA_ACCESS_KEY_SECRET")
+	case TcConnectorKubernetes:
+		envVars = append(commonEnvVars, "WIZ_KUBERNETES_SERVER_ENDPOINT")
+	case TcConnectorAzureDevOps:
+		envVars = append(commonEnvVars, "WIZ_AZURE_DEVOPS_ORGANIZATION", "WIZ_AZURE_DEVOPS_TOKEN")
+	case TcConnectorBitbucket:
+		envVars = append(commonEnvVars, "WIZ_BITBUCKET_WORKSPACE", "WIZ_BITBUCKET_USERNAME", "WIZ_BITBUCKET_APP_PASSWORD")
+	case TcConnectorGitLab:
+		envVars = append(commonEnvVars, "WIZ_GITLAB_GROUP", "WIZ_GITLAB_TOKEN")
+	case TcConnectorJFrog:
+		envVars = append(commonEnvVars, "WIZ_JFROG_URL", "WIZ_JFROG_USERNAME", "WIZ_JFROG_TOKEN")
+	case TcConnectorDockerHub:
+		envVars = append(commonEnvVars, "WIZ_DOCKER_HUB_NAMESPACE", "WIZ_DOCKER_HUB_USERNAME", "WIZ_DOCKER_HUB_TOKEN")
+	case TcConnectorGCR:
+		envVars = append(commonEnvVars, "WIZ_GCR_REGISTRY_URL", "WIZ_GCR_SERVICE_ACCOUNT_KEY")
+	case TcConnectorACR:
+		envVars = append(commonEnvVars, "WIZ_ACR_REGISTRY_URL", "WIZ_AZURE_TENANT_ID", "WIZ_ACR_CLIENT_ID", "WIZ_ACR_CLIENT_SECRET")
+	case TcConnectorECR:
+		envVars = append(commonEnvVars, "WIZ_ECR_REGISTRY_URL", "WIZ_ECR_ROLE_ARN")
+	case TcConnectorGitHub:
+		envVars = append(commonEnvVars, "WIZ_GITHUB_ORGANIZATION", "WIZ_GITHUB_TOKEN")
 	case TcSubscriptionResourceGroups:
 		envVars = append(commonEnvVars, "WIZ_SUBSCRIPTION_ID")
 	case TcProject:
@@ -115,3 +115,77 @@ resource "wiz_automation_rule_aws_sns" "foo" {
   })
 }
 `
+
+func TestAccResourceWizAutomationRuleAwsSNS_filter(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWizAutomationRuleAwsSNSFilter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_aws_sns.foo",
+						"name",
+						"test-acc-WizAutomationRuleAwsSNS_filter",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_aws_sns.foo",
+						"filter.0.severity.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_aws_sns.foo",
+						"filter.0.related_entity.0.cloud_platform.0",
+						"AWS",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_automation_rule_aws_sns.foo",
+						"filters",
+						regexp.MustCompile("b95efbdb-ac2e-4deb-b9a7-23211f3a5d0a"),
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceWizAutomationRuleAwsSNSFilter = `
+resource "wiz_integration_aws_sns" "foo" {
+  name                      = "test-acc-WizAutomationRuleAwsSNS_filter"
+  aws_sns_topic_arn         = "arn:aws:sns:us-east-1:123456789012:Wiz"
+  aws_sns_access_method     = "ASSUME_SPECIFIED_ROLE"
+  aws_sns_customer_role_arn = "arn:aws:iam::123456789012:role/Wiz"
+  scope                     = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_aws_sns" "foo" {
+  name           = "test-acc-WizAutomationRuleAwsSNS_filter"
+  description    = "Terraform provider acceptance test TestAccResourceWizAutomationRuleAwsSNS_filter"
+  enabled        = false
+  integration_id = wiz_integration_aws_sns.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  aws_sns_body = jsonencode({
+    "issue" : {
+      "id" : "{{issue.id}}",
+    }
+  })
+  filter {
+    severity = [
+      "CRITICAL",
+      "HIGH",
+    ]
+    related_entity {
+      cloud_platform = [
+        "AWS",
+      ]
+      subscription_id = [
+        "b95efbdb-ac2e-4deb-b9a7-23211f3a5d0a",
+      ]
+    }
+  }
+}
+`
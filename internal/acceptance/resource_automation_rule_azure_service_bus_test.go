@@ -0,0 +1,80 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleAzureServiceBus_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWizAutomationRuleAzureServiceBusBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_azure_service_bus.foo",
+						"name",
+						"test-acc-WizAutomationRuleAzureServiceBus_basic",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_azure_service_bus.foo",
+						"enabled",
+						"false",
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_azure_service_bus.foo",
+						"id",
+						"wiz_automation_rule_azure_service_bus.foo",
+						"integration_id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_azure_service_bus.foo",
+						"azure_service_bus_body",
+						"{\"issue\":{\"id\":\"{{issue.id}}\",\"severity\":\"{{issue.severity}}\"}}",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_automation_rule_azure_service_bus.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceWizAutomationRuleAzureServiceBusBasic = `
+resource "wiz_integration_azure_service_bus" "foo" {
+  name                                         = "test-acc-WizAutomationRuleAzureServiceBus_basic"
+  azure_service_bus_queue_url                  = "https://wiz-remediation.servicebus.windows.net/issues"
+  azure_service_bus_access_method              = "CONNECTION_STRING_WITH_SAS"
+  azure_service_bus_connection_string_with_sas = "Endpoint=sb://wiz-remediation.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0;EntityPath=issues"
+  scope                                        = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_azure_service_bus" "foo" {
+  name           = "test-acc-WizAutomationRuleAzureServiceBus_basic"
+  description    = "Terraform provider acceptance test TestAccResourceWizAutomationRuleAzureServiceBus_basic"
+  enabled        = false
+  integration_id = wiz_integration_azure_service_bus.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  azure_service_bus_body = jsonencode({
+    "issue" : {
+      "id" : "{{issue.id}}",
+      "severity" : "{{issue.severity}}"
+    }
+  })
+}
+`
@@ -0,0 +1,87 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleClickUpCreateTask_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcClickUp) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizAutomationRuleClickUpCreateTaskBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_clickup_create_task.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_clickup.foo",
+						"id",
+						"wiz_automation_rule_clickup_create_task.foo",
+						"integration_id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_clickup_create_task.foo",
+						"clickup_list_id",
+						os.Getenv("WIZ_INTEGRATION_CLICKUP_LIST_ID"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_clickup_create_task.foo",
+						"clickup_task_name",
+						"Wiz Issue: {{control.name}}",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_clickup_create_task.foo",
+						"clickup_priority",
+						"2",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_automation_rule_clickup_create_task.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceWizAutomationRuleClickUpCreateTaskBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_clickup" "foo" {
+  name  = "%[1]s"
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_clickup_create_task" "foo" {
+  name           = "%[1]s"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = wiz_integration_clickup.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  clickup_list_id  = "%[2]s"
+  clickup_priority = 2
+  clickup_tags = [
+    "wiz",
+  ]
+}
+`, rName, os.Getenv("WIZ_INTEGRATION_CLICKUP_LIST_ID"))
+}
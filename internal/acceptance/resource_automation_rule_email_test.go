@@ -0,0 +1,76 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleEmail_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcEmail) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizAutomationRuleEmailBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_email.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_email.foo",
+						"integration_id",
+						os.Getenv("WIZ_INTEGRATION_EMAIL_ID"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_email.foo",
+						"email_to.0",
+						fmt.Sprintf("%s@%s", rName, os.Getenv("WIZ_SMTP_DOMAIN")),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_email.foo",
+						"email_attach_evidence_csv",
+						"true",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_automation_rule_email.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceWizAutomationRuleEmailBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_automation_rule_email" "foo" {
+  name           = "%[1]s"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = "%[2]s"
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  email_to = [
+    "%[1]s@%[3]s",
+  ]
+  email_note                = "{{issue.control.name}}"
+  email_attach_evidence_csv = true
+}
+`, rName, os.Getenv("WIZ_INTEGRATION_EMAIL_ID"), os.Getenv("WIZ_SMTP_DOMAIN"))
+}
@@ -0,0 +1,85 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleGcpPubSub_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWizAutomationRuleGcpPubSubBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_gcp_pub_sub.foo",
+						"name",
+						"test-acc-WizAutomationRuleGcpPubSub_basic",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_gcp_pub_sub.foo",
+						"enabled",
+						"false",
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_gcp_pub_sub.foo",
+						"id",
+						"wiz_automation_rule_gcp_pub_sub.foo",
+						"integration_id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_gcp_pub_sub.foo",
+						"gcp_pub_sub_body",
+						"{\"issue\":{\"id\":\"{{issue.id}}\",\"severity\":\"{{issue.severity}}\"}}",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_automation_rule_gcp_pub_sub.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceWizAutomationRuleGcpPubSubBasic = `
+resource "wiz_integration_gcp_pub_sub" "foo" {
+  name                      = "test-acc-WizAutomationRuleGcpPubSub_basic"
+  gcp_pub_sub_project_id    = "wiz-remediation"
+  gcp_pub_sub_topic_id      = "wiz-issues"
+  gcp_pub_sub_access_method = "SERVICE_ACCOUNT_KEY"
+  gcp_pub_sub_service_account_key = jsonencode({
+    "type" : "service_account",
+    "project_id" : "wiz-remediation",
+    "client_email" : "wiz-publisher@wiz-remediation.iam.gserviceaccount.com"
+  })
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_gcp_pub_sub" "foo" {
+  name           = "test-acc-WizAutomationRuleGcpPubSub_basic"
+  description    = "Terraform provider acceptance test TestAccResourceWizAutomationRuleGcpPubSub_basic"
+  enabled        = false
+  integration_id = wiz_integration_gcp_pub_sub.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+    "REOPENED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  gcp_pub_sub_body = jsonencode({
+    "issue" : {
+      "id" : "{{issue.id}}",
+      "severity" : "{{issue.severity}}"
+    }
+  })
+}
+`
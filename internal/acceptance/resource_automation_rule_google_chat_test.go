@@ -0,0 +1,67 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleGoogleChat_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcGoogleChat) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizAutomationRuleGoogleChatBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_google_chat.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_google_chat.foo",
+						"integration_id",
+						os.Getenv("WIZ_INTEGRATION_GOOGLE_CHAT_ID"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_google_chat.foo",
+						"google_chat_note",
+						"{{issue.control.name}}",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_automation_rule_google_chat.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceWizAutomationRuleGoogleChatBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_automation_rule_google_chat" "foo" {
+  name           = "%s"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = "%s"
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filter {
+    severity = [
+      "CRITICAL",
+    ]
+  }
+  google_chat_note = "{{issue.control.name}}"
+}
+`, rName, os.Getenv("WIZ_INTEGRATION_GOOGLE_CHAT_ID"))
+}
@@ -0,0 +1,104 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleOpsgenie_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcOpsgenie) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizAutomationRuleOpsgenieBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_opsgenie_create_alert.foo",
+						"name",
+						fmt.Sprintf("%s-create", rName),
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_opsgenie.foo",
+						"id",
+						"wiz_automation_rule_opsgenie_create_alert.foo",
+						"integration_id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_opsgenie_create_alert.foo",
+						"opsgenie_priority",
+						"P2",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_opsgenie_create_alert.foo",
+						"opsgenie_alias",
+						"{{issue.id}}",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_opsgenie_close_alert.foo",
+						"name",
+						fmt.Sprintf("%s-close", rName),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_opsgenie_close_alert.foo",
+						"opsgenie_body",
+						"{\"alias\":\"{{issue.id}}\",\"note\":\"Resolved in Wiz\"}",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizAutomationRuleOpsgenieBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_opsgenie" "foo" {
+  name  = "%[1]s"
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_opsgenie_create_alert" "foo" {
+  name           = "%[1]s-create"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = wiz_integration_opsgenie.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filter {
+    severity = [
+      "HIGH",
+      "MEDIUM",
+    ]
+  }
+  opsgenie_tags = [
+    "wiz",
+  ]
+  opsgenie_priority = "P2"
+}
+
+resource "wiz_automation_rule_opsgenie_close_alert" "foo" {
+  name           = "%[1]s-close"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = wiz_integration_opsgenie.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "RESOLVED",
+  ]
+  filter {
+    severity = [
+      "HIGH",
+      "MEDIUM",
+    ]
+  }
+  opsgenie_note = "Resolved in Wiz"
+}
+`, rName)
+}
@@ -0,0 +1,104 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRulePagerDuty_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcPagerDuty) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizAutomationRulePagerDutyBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_pagerduty_create_incident.foo",
+						"name",
+						fmt.Sprintf("%s-create", rName),
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_pagerduty.foo",
+						"id",
+						"wiz_automation_rule_pagerduty_create_incident.foo",
+						"integration_id",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_automation_rule_pagerduty_create_incident.foo",
+						"pagerduty_payload",
+						regexp.MustCompile("issue.control.name"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_pagerduty_resolve_incident.foo",
+						"name",
+						fmt.Sprintf("%s-resolve", rName),
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"wiz_automation_rule_pagerduty_resolve_incident.foo",
+						"trigger_type.*",
+						"RESOLVED",
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_pagerduty.foo",
+						"id",
+						"wiz_automation_rule_pagerduty_resolve_incident.foo",
+						"integration_id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizAutomationRulePagerDutyBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_pagerduty" "foo" {
+  name  = "%[1]s"
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_pagerduty_create_incident" "foo" {
+  name           = "%[1]s-create"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = wiz_integration_pagerduty.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  pagerduty_payload = jsonencode({
+    "summary" : "{{issue.control.name}}",
+    "severity" : "critical",
+    "source" : "{{issue.entitySnapshot.name}}",
+  })
+}
+
+resource "wiz_automation_rule_pagerduty_resolve_incident" "foo" {
+  name           = "%[1]s-resolve"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = wiz_integration_pagerduty.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "RESOLVED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+}
+`, rName)
+}
@@ -0,0 +1,74 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleSlackBot_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcSlackBot) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizAutomationRuleSlackBotBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_slack_bot.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_slack_bot.foo",
+						"id",
+						"wiz_automation_rule_slack_bot.foo",
+						"integration_id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_slack_bot.foo",
+						"slack_bot_channel",
+						os.Getenv("WIZ_INTEGRATION_SLACK_BOT_CHANNEL"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_slack_bot.foo",
+						"slack_bot_note",
+						"New issue: {{issue.control.name}}",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizAutomationRuleSlackBotBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_slack_bot" "foo" {
+  name  = "%s"
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_slack_bot" "foo" {
+  name           = "%s"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = wiz_integration_slack_bot.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  slack_bot_channel = "%s"
+  slack_bot_note    = "New issue: {{issue.control.name}}"
+}
+`, rName, rName, os.Getenv("WIZ_INTEGRATION_SLACK_BOT_CHANNEL"))
+}
@@ -0,0 +1,72 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleSlack_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcSlack) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizAutomationRuleSlackBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_slack.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_slack.foo",
+						"enabled",
+						"false",
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_slack.foo",
+						"id",
+						"wiz_automation_rule_slack.foo",
+						"integration_id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_slack.foo",
+						"slack_note",
+						"New issue: {{issue.control.name}}",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizAutomationRuleSlackBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_slack" "foo" {
+  name  = "%s"
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_slack" "foo" {
+  name           = "%s"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = wiz_integration_slack.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  slack_note = "New issue: {{issue.control.name}}"
+}
+`, rName, rName)
+}
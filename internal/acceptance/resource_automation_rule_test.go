@@ -0,0 +1,113 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRule_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWizAutomationRuleBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule.foo",
+						"name",
+						"test-acc-WizAutomationRule_basic",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule.foo",
+						"enabled",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule.foo",
+						"action.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule.foo",
+						"action.0.type",
+						"AWS_SNS",
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_aws_sns.foo",
+						"id",
+						"wiz_automation_rule.foo",
+						"action.0.integration_id",
+					),
+					resource.TestCheckResourceAttrSet(
+						"wiz_automation_rule.foo",
+						"action.0.id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule.foo",
+						"action.1.type",
+						"AWS_SNS",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule.foo",
+						"action.1.aws_sns.0.body",
+						"{\"issue\":{\"severity\":\"{{issue.severity}}\"}}",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_automation_rule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceWizAutomationRuleBasic = `
+resource "wiz_integration_aws_sns" "foo" {
+  name                      = "test-acc-WizAutomationRule_basic"
+  aws_sns_topic_arn         = "arn:aws:sns:us-east-1:123456789012:Wiz"
+  aws_sns_access_method     = "ASSUME_SPECIFIED_ROLE"
+  aws_sns_customer_role_arn = "arn:aws:iam::123456789012:role/Wiz"
+  scope                     = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule" "foo" {
+  name           = "test-acc-WizAutomationRule_basic"
+  description    = "Terraform provider acceptance test TestAccResourceWizAutomationRule_basic"
+  enabled        = false
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL",
+    ]
+  })
+
+  action {
+    integration_id = wiz_integration_aws_sns.foo.id
+    aws_sns {
+      body = jsonencode({
+        "issue" : {
+          "id" : "{{issue.id}}",
+        }
+      })
+    }
+  }
+
+  action {
+    integration_id = wiz_integration_aws_sns.foo.id
+    aws_sns {
+      body = jsonencode({
+        "issue" : {
+          "severity" : "{{issue.severity}}",
+        }
+      })
+    }
+  }
+}
+`
@@ -0,0 +1,92 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleWebhook_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcCommon) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizAutomationRuleWebhookBasic(rName, "{{#issue.projects}}{{name}}{{/issue.projects}}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_webhook.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttrPair(
+						"wiz_integration_webhook.foo",
+						"id",
+						"wiz_automation_rule_webhook.foo",
+						"integration_id",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_automation_rule_webhook.foo",
+						"webhook_body",
+						regexp.MustCompile("issue.projects"),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceWizAutomationRuleWebhook_invalidTemplate(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcCommon) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testResourceWizAutomationRuleWebhookBasic(rName, "{{#issue.projects}}{{name}}"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("invalid template"),
+			},
+		},
+	})
+}
+
+func testResourceWizAutomationRuleWebhookBasic(rName string, projects string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_webhook" "foo" {
+  name        = "%s"
+  scope       = "All Resources, Restrict this Integration to global roles only"
+  webhook_url = "https://example.com/hooks/wiz"
+}
+
+resource "wiz_automation_rule_webhook" "foo" {
+  name           = "%s"
+  description    = "Provider Acceptance Test"
+  enabled        = false
+  integration_id = wiz_integration_webhook.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  filters = jsonencode({
+    "severity" : [
+      "CRITICAL"
+    ]
+  })
+  webhook_body = jsonencode({
+    "id" : "{{issue.id}}",
+    "projects" : "%s",
+  })
+  webhook_header {
+    key   = "X-Source"
+    value = "wiz"
+  }
+}
+`, rName, rName, projects)
+}
@@ -0,0 +1,78 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorACR_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorACR)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorACRBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_acr.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_acr.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_acr.foo",
+						"registry_url",
+						os.Getenv("WIZ_ACR_REGISTRY_URL"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_acr.foo",
+						"excluded_repositories.0",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_acr.foo",
+						"scan_frequency",
+						"WEEKLY",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_acr.foo",
+						"status",
+						regexp.MustCompile(`\w`),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorACRBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_acr" "foo" {
+  name                  = "%[1]s"
+  enabled               = false
+  registry_url          = "%[2]s"
+  tenant_id             = "%[3]s"
+  client_id             = "%[4]s"
+  client_secret         = "%[5]s"
+  excluded_repositories = ["%[1]s"]
+  scan_frequency        = "WEEKLY"
+}
+`,
+		rName,
+		os.Getenv("WIZ_ACR_REGISTRY_URL"),
+		os.Getenv("WIZ_AZURE_TENANT_ID"),
+		os.Getenv("WIZ_ACR_CLIENT_ID"),
+		os.Getenv("WIZ_ACR_CLIENT_SECRET"),
+	)
+}
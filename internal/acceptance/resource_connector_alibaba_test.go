@@ -0,0 +1,71 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorAlibaba_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+	accountID := os.Getenv("WIZ_ALIBABA_ACCOUNT_ID")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorAlibaba)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorAlibabaBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_alibaba.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_alibaba.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_alibaba.foo",
+						"account_id",
+						accountID,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_alibaba.foo",
+						"status",
+						regexp.MustCompile(`\w`),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorAlibabaBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_alibaba" "foo" {
+  name    = "%[1]s"
+  enabled = false
+  auth_params = jsonencode({
+    "accountId" : "%[2]s",
+    "accessKeyId" : "%[3]s",
+    "accessKeySecret" : "%[4]s",
+  })
+  extra_config = jsonencode({
+    "excludedAccounts" : [],
+    "diskAnalyzerInFlightDisabled" : false,
+  })
+}
+`,
+		rName,
+		os.Getenv("WIZ_ALIBABA_ACCOUNT_ID"),
+		os.Getenv("WIZ_ALIBABA_ACCESS_KEY_ID"),
+		os.Getenv("WIZ_ALIBABA_ACCESS_KEY_SECRET"),
+	)
+}
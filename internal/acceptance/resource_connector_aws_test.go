@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccResourceWizConnectorAws_basic(t *testing.T) {
@@ -34,6 +35,15 @@ func TestAccResourceWizConnectorAws_basic(t *testing.T) {
 						"external_id_nonce",
 						regexp.MustCompile(UUIDPattern),
 					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_aws.foo",
+						"status",
+						regexp.MustCompile(`\w`),
+					),
+					resource.TestCheckResourceAttrSet(
+						"wiz_connector_aws.foo",
+						"cloud_account_count",
+					),
 					resource.TestCheckTypeSetElemAttr(
 						"wiz_connector_aws.foo",
 						"opted_in_regions.*",
@@ -100,3 +110,65 @@ func testResourceWizConnectorAwsBasic(rName string) string {
 	}
 `, rName)
 }
+
+func TestAccResourceWizConnectorAws_customerRole(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorAwsCustomerRole(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_aws.foo",
+						"customer_role.0.role_arn",
+						fmt.Sprintf("arn:aws:iam::000000000000:role/%s-first", rName),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_aws.foo",
+						"customer_role_arn",
+						fmt.Sprintf("arn:aws:iam::000000000000:role/%s-first", rName),
+					),
+				),
+			},
+			{
+				// the customer role is updated in place
+				Config: testResourceWizConnectorAwsCustomerRole(rName, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("wiz_connector_aws.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_aws.foo",
+						"customer_role_arn",
+						fmt.Sprintf("arn:aws:iam::000000000000:role/%s-second", rName),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorAwsCustomerRole(rName string, roleSuffix string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_aws" "foo" {
+  name = "%[1]s"
+
+  customer_role {
+    role_arn = "arn:aws:iam::000000000000:role/%[1]s-%[2]s"
+  }
+
+  extra_config = jsonencode(
+    {
+      "skipOrganizationScan" : true,
+      "optedInRegions" : ["us-east-1"],
+      "auditLogMonitorEnabled" : false
+    }
+  )
+}
+`, rName, roleSuffix)
+}
@@ -0,0 +1,78 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorAzure_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+	tenantID := os.Getenv("WIZ_AZURE_TENANT_ID")
+	subscriptionID := os.Getenv("WIZ_SUBSCRIPTION_ID")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorAzure)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorAzureBasic(rName, tenantID, subscriptionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_azure.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_azure.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_azure.foo",
+						"tenant_id",
+						tenantID,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_azure.foo",
+						"subscription_id",
+						subscriptionID,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_azure.foo",
+						"enabled",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_azure.foo",
+						"disk_analyzer_inflight_disabled",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorAzureBasic(rName string, tenantID string, subscriptionID string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_azure" "foo" {
+  name    = "%[1]s"
+  enabled = false
+  auth_params = jsonencode({
+    "isManagedIdentity" : true,
+    "tenantId" : "%[2]s",
+    "subscriptionId" : "%[3]s",
+  })
+  extra_config = jsonencode({
+    "excludedSubscriptions" : [],
+    "diskAnalyzerInFlightDisabled" : true,
+    "auditLogMonitorEnabled" : false,
+  })
+}
+`, rName, tenantID, subscriptionID)
+}
@@ -0,0 +1,71 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorBitbucket_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorBitbucket)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorBitbucketBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_bitbucket.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_bitbucket.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_bitbucket.foo",
+						"workspace",
+						os.Getenv("WIZ_BITBUCKET_WORKSPACE"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_bitbucket.foo",
+						"excluded_repositories.0",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_bitbucket.foo",
+						"pull_request_scanning_enabled",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorBitbucketBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_bitbucket" "foo" {
+  name                          = "%[1]s"
+  enabled                       = false
+  workspace                     = "%[2]s"
+  username                      = "%[3]s"
+  app_password                  = "%[4]s"
+  excluded_repositories         = ["%[1]s"]
+  pull_request_scanning_enabled = true
+}
+`,
+		rName,
+		os.Getenv("WIZ_BITBUCKET_WORKSPACE"),
+		os.Getenv("WIZ_BITBUCKET_USERNAME"),
+		os.Getenv("WIZ_BITBUCKET_APP_PASSWORD"),
+	)
+}
@@ -0,0 +1,76 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorDockerHub_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorDockerHub)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorDockerHubBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_docker_hub.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_docker_hub.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_docker_hub.foo",
+						"namespace",
+						os.Getenv("WIZ_DOCKER_HUB_NAMESPACE"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_docker_hub.foo",
+						"excluded_repositories.0",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_docker_hub.foo",
+						"scan_frequency",
+						"WEEKLY",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_docker_hub.foo",
+						"status",
+						regexp.MustCompile(`\w`),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorDockerHubBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_docker_hub" "foo" {
+  name                  = "%[1]s"
+  enabled               = false
+  namespace             = "%[2]s"
+  username              = "%[3]s"
+  access_token          = "%[4]s"
+  excluded_repositories = ["%[1]s"]
+  scan_frequency        = "WEEKLY"
+}
+`,
+		rName,
+		os.Getenv("WIZ_DOCKER_HUB_NAMESPACE"),
+		os.Getenv("WIZ_DOCKER_HUB_USERNAME"),
+		os.Getenv("WIZ_DOCKER_HUB_TOKEN"),
+	)
+}
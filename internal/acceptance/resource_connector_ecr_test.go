@@ -0,0 +1,74 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorECR_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorECR)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorECRBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_ecr.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_ecr.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_ecr.foo",
+						"registry_url",
+						os.Getenv("WIZ_ECR_REGISTRY_URL"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_ecr.foo",
+						"excluded_repositories.0",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_ecr.foo",
+						"scan_frequency",
+						"WEEKLY",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_ecr.foo",
+						"status",
+						regexp.MustCompile(`\w`),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorECRBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_ecr" "foo" {
+  name                  = "%[1]s"
+  enabled               = false
+  registry_url          = "%[2]s"
+  role_arn              = "%[3]s"
+  excluded_repositories = ["%[1]s"]
+  scan_frequency        = "WEEKLY"
+}
+`,
+		rName,
+		os.Getenv("WIZ_ECR_REGISTRY_URL"),
+		os.Getenv("WIZ_ECR_ROLE_ARN"),
+	)
+}
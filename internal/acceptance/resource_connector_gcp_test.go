@@ -81,3 +81,57 @@ func testResourceWizConnectorGcpBasic(rName string) string {
 	  }
 `, rName)
 }
+
+func TestAccResourceWizConnectorGcp_structuredExtraConfig(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorGcpStructuredExtraConfig(rName, `excluded_projects = ["example-sandbox-project"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gcp.foo",
+						"excluded_projects.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gcp.foo",
+						"excluded_projects.0",
+						"example-sandbox-project",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gcp.foo",
+						"audit_log_monitor_enabled",
+						"false",
+					),
+				),
+			},
+			{
+				Config: testResourceWizConnectorGcpStructuredExtraConfig(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gcp.foo",
+						"excluded_projects.#",
+						"0",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorGcpStructuredExtraConfig(rName string, extraConfig string) string {
+	return fmt.Sprintf(`
+	resource "wiz_connector_gcp" "foo" {
+		name = "%[1]s"
+		auth_params = jsonencode({
+		  "isManagedIdentity" : true,
+		  "folder_id" : "123456",
+		})
+		%[2]s
+	  }
+`, rName, extraConfig)
+}
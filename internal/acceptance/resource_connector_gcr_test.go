@@ -0,0 +1,74 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorGCR_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorGCR)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorGCRBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gcr.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_gcr.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gcr.foo",
+						"registry_url",
+						os.Getenv("WIZ_GCR_REGISTRY_URL"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gcr.foo",
+						"excluded_repositories.0",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gcr.foo",
+						"scan_frequency",
+						"WEEKLY",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_gcr.foo",
+						"status",
+						regexp.MustCompile(`\w`),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorGCRBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_gcr" "foo" {
+  name                  = "%[1]s"
+  enabled               = false
+  registry_url          = "%[2]s"
+  service_account_key   = %[3]q
+  excluded_repositories = ["%[1]s"]
+  scan_frequency        = "WEEKLY"
+}
+`,
+		rName,
+		os.Getenv("WIZ_GCR_REGISTRY_URL"),
+		os.Getenv("WIZ_GCR_SERVICE_ACCOUNT_KEY"),
+	)
+}
@@ -0,0 +1,69 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorGitHub_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorGitHub)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorGitHubBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_github.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_github.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_github.foo",
+						"organization",
+						os.Getenv("WIZ_GITHUB_ORGANIZATION"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_github.foo",
+						"excluded_repositories.0",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_github.foo",
+						"pull_request_scanning_enabled",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorGitHubBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_github" "foo" {
+  name                          = "%[1]s"
+  enabled                       = false
+  organization                  = "%[2]s"
+  personal_access_token         = "%[3]s"
+  excluded_repositories         = ["%[1]s"]
+  pull_request_scanning_enabled = true
+}
+`,
+		rName,
+		os.Getenv("WIZ_GITHUB_ORGANIZATION"),
+		os.Getenv("WIZ_GITHUB_TOKEN"),
+	)
+}
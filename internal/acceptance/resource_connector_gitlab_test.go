@@ -0,0 +1,69 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorGitLab_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorGitLab)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorGitLabBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gitlab.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_gitlab.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gitlab.foo",
+						"group",
+						os.Getenv("WIZ_GITLAB_GROUP"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gitlab.foo",
+						"excluded_repositories.0",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_gitlab.foo",
+						"pull_request_scanning_enabled",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorGitLabBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_gitlab" "foo" {
+  name                          = "%[1]s"
+  enabled                       = false
+  group                         = "%[2]s"
+  access_token                  = "%[3]s"
+  excluded_repositories         = ["%[1]s"]
+  pull_request_scanning_enabled = true
+}
+`,
+		rName,
+		os.Getenv("WIZ_GITLAB_GROUP"),
+		os.Getenv("WIZ_GITLAB_TOKEN"),
+	)
+}
@@ -0,0 +1,76 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorJFrog_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorJFrog)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorJFrogBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_jfrog.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_jfrog.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_jfrog.foo",
+						"registry_url",
+						os.Getenv("WIZ_JFROG_URL"),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_jfrog.foo",
+						"excluded_repositories.0",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_jfrog.foo",
+						"scan_frequency",
+						"WEEKLY",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_jfrog.foo",
+						"status",
+						regexp.MustCompile(`\w`),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorJFrogBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_jfrog" "foo" {
+  name                  = "%[1]s"
+  enabled               = false
+  registry_url          = "%[2]s"
+  username              = "%[3]s"
+  access_token          = "%[4]s"
+  excluded_repositories = ["%[1]s"]
+  scan_frequency        = "WEEKLY"
+}
+`,
+		rName,
+		os.Getenv("WIZ_JFROG_URL"),
+		os.Getenv("WIZ_JFROG_USERNAME"),
+		os.Getenv("WIZ_JFROG_TOKEN"),
+	)
+}
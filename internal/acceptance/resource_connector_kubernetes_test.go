@@ -0,0 +1,74 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorKubernetes_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorKubernetes)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorKubernetesBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_kubernetes.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_kubernetes.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_kubernetes.foo",
+						"service_account_type",
+						"BROKER",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_kubernetes.foo",
+						"service_account_id",
+						regexp.MustCompile(`\w`),
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_kubernetes.foo",
+						"client_id",
+						regexp.MustCompile(`\w`),
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_kubernetes.foo",
+						"client_secret",
+						regexp.MustCompile(`\w`),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorKubernetesBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_kubernetes" "foo" {
+  name                 = "%[1]s"
+  enabled              = false
+  service_account_type = "BROKER"
+  auth_params = jsonencode({
+    "isOnPrem" : true,
+    "serverEndpoint" : "%[2]s",
+  })
+}
+`,
+		rName,
+		os.Getenv("WIZ_KUBERNETES_SERVER_ENDPOINT"),
+	)
+}
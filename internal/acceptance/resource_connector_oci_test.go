@@ -0,0 +1,75 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizConnectorOci_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+	tenancyOCID := os.Getenv("WIZ_OCI_TENANCY_OCID")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcConnectorOCI)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizConnectorOciBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_connector_oci.foo",
+						"name",
+						rName,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_oci.foo",
+						"id",
+						regexp.MustCompile(UUIDPattern),
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_connector_oci.foo",
+						"tenancy_ocid",
+						tenancyOCID,
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_connector_oci.foo",
+						"status",
+						regexp.MustCompile(`\w`),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizConnectorOciBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_connector_oci" "foo" {
+  name    = "%[1]s"
+  enabled = false
+  auth_params = jsonencode({
+    "tenancyOCID" : "%[2]s",
+    "userOCID" : "%[3]s",
+    "fingerprint" : "%[4]s",
+    "privateKey" : %[5]q,
+    "homeRegion" : "%[6]s",
+  })
+  extra_config = jsonencode({
+    "excludedCompartments" : [],
+    "diskAnalyzerInFlightDisabled" : false,
+  })
+}
+`,
+		rName,
+		os.Getenv("WIZ_OCI_TENANCY_OCID"),
+		os.Getenv("WIZ_OCI_USER_OCID"),
+		os.Getenv("WIZ_OCI_FINGERPRINT"),
+		os.Getenv("WIZ_OCI_PRIVATE_KEY"),
+		os.Getenv("WIZ_OCI_HOME_REGION"),
+	)
+}
@@ -0,0 +1,114 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizHostConfigRule_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizHostConfigRuleBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_host_config_rule.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_host_config_rule.foo",
+						"description",
+						"test description",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_host_config_rule.foo",
+						"enabled",
+						"false",
+					),
+					resource.TestMatchResourceAttr(
+						"wiz_host_config_rule.foo",
+						"direct_oval",
+						regexp.MustCompile("oval_definitions"),
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_host_config_rule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceWizHostConfigRule_invalidOVAL(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "wiz_host_config_rule" "foo" {
+  name        = "%s"
+  description = "test description"
+  direct_oval = "<oval_definitions><definitions></oval_definitions>"
+}
+`, rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("not well-formed XML"),
+			},
+		},
+	})
+}
+
+func testResourceWizHostConfigRuleBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_host_config_rule" "foo" {
+  name        = "%s"
+  description = "test description"
+  enabled     = false
+  direct_oval = <<EOT
+<?xml version="1.0" encoding="UTF-8"?>
+<oval_definitions xmlns="http://oval.mitre.org/XMLSchema/oval-definitions-5" xmlns:oval="http://oval.mitre.org/XMLSchema/oval-common-5" xmlns:ind="http://oval.mitre.org/XMLSchema/oval-definitions-5#independent">
+  <generator>
+    <oval:schema_version>5.11.2</oval:schema_version>
+  </generator>
+  <definitions>
+    <definition id="oval:com.example:def:1" version="1" class="compliance">
+      <metadata>
+        <title>Ensure SSH root login is disabled</title>
+        <description>PermitRootLogin must be set to no.</description>
+      </metadata>
+      <criteria>
+        <criterion test_ref="oval:com.example:tst:1" comment="PermitRootLogin is no"/>
+      </criteria>
+    </definition>
+  </definitions>
+  <tests>
+    <ind:textfilecontent54_test id="oval:com.example:tst:1" version="1" check="all" check_existence="all_exist" comment="PermitRootLogin is no">
+      <ind:object object_ref="oval:com.example:obj:1"/>
+    </ind:textfilecontent54_test>
+  </tests>
+  <objects>
+    <ind:textfilecontent54_object id="oval:com.example:obj:1" version="1">
+      <ind:filepath>/etc/ssh/sshd_config</ind:filepath>
+      <ind:pattern operation="pattern match">^\s*PermitRootLogin\s+no\s*$</ind:pattern>
+      <ind:instance datatype="int">1</ind:instance>
+    </ind:textfilecontent54_object>
+  </objects>
+</oval_definitions>
+EOT
+}
+`, rName)
+}
@@ -0,0 +1,51 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizIntegrationAzureServiceBus_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWizIntegrationAzureServiceBusBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_integration_azure_service_bus.foo",
+						"name",
+						"test-acc-WizIntegrationAzureServiceBus_basic",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_azure_service_bus.foo",
+						"azure_service_bus_queue_url",
+						"https://wiz-remediation.servicebus.windows.net/issues",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_azure_service_bus.foo",
+						"azure_service_bus_access_method",
+						"CONNECTION_STRING_WITH_SAS",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_azure_service_bus.foo",
+						"scope",
+						"All Resources, Restrict this Integration to global roles only",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceWizIntegrationAzureServiceBusBasic = `
+resource "wiz_integration_azure_service_bus" "foo" {
+  name                                         = "test-acc-WizIntegrationAzureServiceBus_basic"
+  azure_service_bus_queue_url                  = "https://wiz-remediation.servicebus.windows.net/issues"
+  azure_service_bus_access_method              = "CONNECTION_STRING_WITH_SAS"
+  azure_service_bus_connection_string_with_sas = "Endpoint=sb://wiz-remediation.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0;EntityPath=issues"
+  scope                                        = "All Resources, Restrict this Integration to global roles only"
+}
+`
@@ -0,0 +1,50 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizIntegrationClickUp_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcClickUp) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizIntegrationClickUpBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_integration_clickup.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_clickup.foo",
+						"scope",
+						"All Resources, Restrict this Integration to global roles only",
+					),
+				),
+			},
+			{
+				ResourceName:            "wiz_integration_clickup.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"scope", "clickup_api_key"},
+			},
+		},
+	})
+}
+
+func testResourceWizIntegrationClickUpBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_clickup" "foo" {
+  name  = "%s"
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+`, rName)
+}
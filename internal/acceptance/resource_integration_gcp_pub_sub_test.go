@@ -0,0 +1,61 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizIntegrationGcpPubSub_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWizIntegrationGcpPubSubBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_integration_gcp_pub_sub.foo",
+						"name",
+						"test-acc-WizIntegrationGcpPubSub_basic",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_gcp_pub_sub.foo",
+						"gcp_pub_sub_project_id",
+						"wiz-remediation",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_gcp_pub_sub.foo",
+						"gcp_pub_sub_topic_id",
+						"wiz-issues",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_gcp_pub_sub.foo",
+						"gcp_pub_sub_access_method",
+						"SERVICE_ACCOUNT_KEY",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_gcp_pub_sub.foo",
+						"scope",
+						"All Resources, Restrict this Integration to global roles only",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceWizIntegrationGcpPubSubBasic = `
+resource "wiz_integration_gcp_pub_sub" "foo" {
+  name                      = "test-acc-WizIntegrationGcpPubSub_basic"
+  gcp_pub_sub_project_id    = "wiz-remediation"
+  gcp_pub_sub_topic_id      = "wiz-issues"
+  gcp_pub_sub_access_method = "SERVICE_ACCOUNT_KEY"
+  gcp_pub_sub_service_account_key = jsonencode({
+    "type" : "service_account",
+    "project_id" : "wiz-remediation",
+    "client_email" : "wiz-publisher@wiz-remediation.iam.gserviceaccount.com"
+  })
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+`
@@ -0,0 +1,50 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizIntegrationOpsgenie_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcOpsgenie) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizIntegrationOpsgenieBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_integration_opsgenie.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_opsgenie.foo",
+						"scope",
+						"All Resources, Restrict this Integration to global roles only",
+					),
+				),
+			},
+			{
+				ResourceName:            "wiz_integration_opsgenie.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"scope", "opsgenie_api_key"},
+			},
+		},
+	})
+}
+
+func testResourceWizIntegrationOpsgenieBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_opsgenie" "foo" {
+  name  = "%s"
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+`, rName)
+}
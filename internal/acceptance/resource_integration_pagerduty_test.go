@@ -0,0 +1,50 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizIntegrationPagerDuty_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcPagerDuty) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizIntegrationPagerDutyBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_integration_pagerduty.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_pagerduty.foo",
+						"scope",
+						"All Resources, Restrict this Integration to global roles only",
+					),
+				),
+			},
+			{
+				ResourceName:            "wiz_integration_pagerduty.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"scope", "pagerduty_routing_key"},
+			},
+		},
+	})
+}
+
+func testResourceWizIntegrationPagerDutyBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_pagerduty" "foo" {
+  name  = "%s"
+  scope = "All Resources, Restrict this Integration to global roles only"
+}
+`, rName)
+}
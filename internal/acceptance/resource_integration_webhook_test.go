@@ -0,0 +1,66 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizIntegrationWebhook_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TcCommon) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizIntegrationWebhookBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_integration_webhook.foo",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_webhook.foo",
+						"webhook_url",
+						"https://example.com/hooks/wiz",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_webhook.foo",
+						"webhook_username",
+						"wiz",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_webhook.foo",
+						"webhook_header.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_integration_webhook.foo",
+						"webhook_header.0.key",
+						"X-Source",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizIntegrationWebhookBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_webhook" "foo" {
+  name             = "%s"
+  scope            = "All Resources, Restrict this Integration to global roles only"
+  webhook_url      = "https://example.com/hooks/wiz"
+  webhook_username = "wiz"
+  webhook_password = "%s"
+  webhook_header {
+    key   = "X-Source"
+    value = "wiz"
+  }
+}
+`, rName, acctest.RandString(16))
+}
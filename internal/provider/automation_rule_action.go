@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// automationRuleAction describes an automation rule resource with a single action, built on an action template block of wiz_automation_rule
+type automationRuleAction struct {
+	// resourceType is the resource name without the provider prefix, e.g. automation_rule_slack_bot
+	resourceType string
+	// templateName is the action template block of wiz_automation_rule
+	templateName string
+	// prefix is prepended to the attributes of the action template block
+	prefix string
+	// integration describes the integration to leverage for the action
+	integration string
+	// schema holds the action attributes that replace or extend the prefixed attributes of the action template block
+	schema map[string]*schema.Schema
+	// expand returns the action template block from the action attributes, the prefixed attributes are used when nil
+	expand func(ctx context.Context, d *schema.ResourceData) (map[string]interface{}, error)
+	// flatten sets the action attributes from the action template block, the prefixed attributes are set when nil
+	flatten func(ctx context.Context, d *schema.ResourceData, template map[string]interface{}) error
+}
+
+// resourceWizAutomationRuleAction returns the resource of an automation rule with a single action
+func resourceWizAutomationRuleAction(a automationRuleAction) *schema.Resource {
+	ruleSchema := automationRuleSchema()
+	ruleSchema["action_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Wiz internal ID for the action.",
+	}
+	ruleSchema["integration_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: fmt.Sprintf("Wiz identifier for the Integration to leverage for this action. %s", a.integration),
+	}
+	for name, attribute := range automationRuleActionTemplateSchema(a.templateName) {
+		prefixed := *attribute
+		ruleSchema[a.prefix+name] = &prefixed
+	}
+	for name, attribute := range a.schema {
+		ruleSchema[name] = attribute
+	}
+
+	return &schema.Resource{
+		Description:   "Automation Rules define associations between actions and findings.",
+		Schema:        ruleSchema,
+		CustomizeDiff: automationRuleFiltersCustomizeDiff,
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceWizAutomationRuleActionCreate(ctx, d, m, a)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceWizAutomationRuleActionRead(ctx, d, m, a)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceWizAutomationRuleActionUpdate(ctx, d, m, a)
+		},
+		DeleteContext: resourceWizAutomationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// automationRuleActionTemplateSchema returns the attributes of an action template block of wiz_automation_rule
+func automationRuleActionTemplateSchema(templateName string) map[string]*schema.Schema {
+	action := resourceWizAutomationRule().Schema["action"].Elem.(*schema.Resource)
+	return action.Schema[templateName].Elem.(*schema.Resource).Schema
+}
+
+func resourceWizAutomationRuleActionCreate(ctx context.Context, d *schema.ResourceData, m interface{}, a automationRuleAction) (diags diag.Diagnostics) {
+	tflog.Info(ctx, fmt.Sprintf("resourceWizAutomationRuleActionCreate called for %s...", a.resourceType))
+
+	actions, err := getAutomationRuleActionResourceActions(ctx, d, a)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	diags = createAutomationRule(ctx, d, m, actions, a.resourceType)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizAutomationRuleActionRead(ctx, d, m, a)
+}
+
+func resourceWizAutomationRuleActionRead(ctx context.Context, d *schema.ResourceData, m interface{}, a automationRuleAction) (diags diag.Diagnostics) {
+	tflog.Info(ctx, fmt.Sprintf("resourceWizAutomationRuleActionRead called for %s...", a.resourceType))
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	rule, diags := readAutomationRule(ctx, d, m, a.resourceType)
+	if rule == nil {
+		return diags
+	}
+
+	return append(diags, flattenAutomationRuleActionResource(ctx, d, a, rule.Actions)...)
+}
+
+func resourceWizAutomationRuleActionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}, a automationRuleAction) (diags diag.Diagnostics) {
+	tflog.Info(ctx, fmt.Sprintf("resourceWizAutomationRuleActionUpdate called for %s...", a.resourceType))
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	actions, err := getAutomationRuleActionResourceActions(ctx, d, a)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	diags = updateAutomationRule(ctx, d, m, actions, a.resourceType)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizAutomationRuleActionRead(ctx, d, m, a)
+}
+
+// getAutomationRuleActionResourceActions returns the action of an automation rule resource with a single action
+func getAutomationRuleActionResourceActions(ctx context.Context, d *schema.ResourceData, a automationRuleAction) ([]wiz.AutomationRuleActionInput, error) {
+	tflog.Info(ctx, "getAutomationRuleActionResourceActions called...")
+
+	var template map[string]interface{}
+	if a.expand != nil {
+		var err error
+		template, err = a.expand(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		template = make(map[string]interface{})
+		for name := range automationRuleActionTemplateSchema(a.templateName) {
+			template[name] = d.Get(a.prefix + name)
+		}
+	}
+
+	// the action template type never changes, so the prior action id is always retained
+	actionConfig := map[string]interface{}{
+		"integration_id": d.Get("integration_id").(string),
+		a.templateName:   []interface{}{template},
+	}
+	priorAction := map[string]interface{}{
+		"id":           d.Get("action_id").(string),
+		a.templateName: []interface{}{template},
+	}
+
+	return getAutomationRuleActionInputs(ctx, []interface{}{actionConfig}, []interface{}{priorAction}), nil
+}
+
+// flattenAutomationRuleActionResource sets the action attributes of an automation rule resource with a single action
+func flattenAutomationRuleActionResource(ctx context.Context, d *schema.ResourceData, a automationRuleAction, actions []*wiz.AutomationRuleAction) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "flattenAutomationRuleActionResource called...")
+
+	flattened, diags := flattenAutomationRuleActions(ctx, actions, nil)
+	if diags.HasError() {
+		return diags
+	}
+	if len(flattened) != 1 {
+		return append(diags, diag.Errorf("automation rule %s has %d actions, %s manages a single action", d.Id(), len(flattened), a.resourceType)...)
+	}
+	action := flattened[0].(map[string]interface{})
+	templates, _ := action[a.templateName].([]interface{})
+	if len(templates) == 0 {
+		return append(diags, diag.Errorf("automation rule %s has an action of type %s, %s manages an action of type %s", d.Id(), actions[0].ActionTemplateType, a.resourceType, automationRuleActionTemplateTypes[a.templateName])...)
+	}
+	template := templates[0].(map[string]interface{})
+
+	err := d.Set("action_id", action["id"])
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("integration_id", action["integration_id"])
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if a.flatten != nil {
+		err = a.flatten(ctx, d, template)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+	for name, value := range template {
+		err = d.Set(a.prefix+name, value)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
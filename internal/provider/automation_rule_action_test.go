@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestGetAutomationRuleActionResourceActions(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []wiz.AutomationRuleActionInput{
+		{
+			ID:                 "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			IntegrationID:      "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			ActionTemplateType: "SLACK_BOT",
+			ActionTemplateParams: wiz.ActionTemplateParamsInput{
+				SlackBot: &wiz.SlackBotActionTemplateParamsInput{
+					Channel: "#security",
+					Note:    "{{issue.id}}",
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleSlackBot().Schema,
+		map[string]interface{}{
+			"integration_id":    "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			"slack_bot_channel": "#security",
+			"slack_bot_note":    "{{issue.id}}",
+		},
+	)
+	err := d.Set("action_id", "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions, err := getAutomationRuleActionResourceActions(ctx, d, automationRuleAction{
+		resourceType: "automation_rule_slack_bot",
+		templateName: "slack_bot",
+		prefix:       "slack_bot_",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actions,
+			expected,
+		)
+	}
+}
+
+func TestFlattenAutomationRuleActionResource(t *testing.T) {
+	ctx := context.Background()
+
+	a := automationRuleAction{
+		resourceType: "automation_rule_email",
+		templateName: "email",
+		prefix:       "email_",
+	}
+
+	actions := []*wiz.AutomationRuleAction{
+		{
+			ID:                 "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			ActionTemplateType: "EMAIL",
+			Integration: wiz.Integration{
+				ID: "wiz-email-integration",
+			},
+			ActionTemplateParams: map[string]interface{}{
+				"to": []interface{}{
+					"security@example.com",
+				},
+				"note":              "{{issue.id}}",
+				"attachEvidenceCSV": true,
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceWizAutomationRuleEmail().Schema, map[string]interface{}{})
+	diags := flattenAutomationRuleActionResource(ctx, d, a, actions)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %#v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"action_id":                 "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+		"integration_id":            "wiz-email-integration",
+		"email_to":                  []interface{}{"security@example.com"},
+		"email_cc":                  []interface{}{},
+		"email_note":                "{{issue.id}}",
+		"email_attach_evidence_csv": true,
+	}
+	for key, value := range expected {
+		if !reflect.DeepEqual(d.Get(key), value) {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				d.Get(key),
+				value,
+			)
+		}
+	}
+
+	// an action of another action template type is not managed by the resource
+	actions[0].ActionTemplateType = "SLACK"
+	actions[0].ActionTemplateParams = map[string]interface{}{
+		"note": "{{issue.id}}",
+	}
+	diags = flattenAutomationRuleActionResource(ctx, d, a, actions)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error diagnostic, got %#v", diags)
+	}
+}
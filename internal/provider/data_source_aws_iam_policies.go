@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+// awsIAMPolicyDeploymentType enum
+var awsIAMPolicyDeploymentType = []string{
+	"STANDARD",
+	"OUTPOST",
+}
+
+// awsIAMPolicyDocument is an AWS IAM policy document
+type awsIAMPolicyDocument struct {
+	Version   string                  `json:"Version"`
+	Statement []awsIAMPolicyStatement `json:"Statement"`
+}
+
+// awsIAMPolicyStatement is a statement of an AWS IAM policy document
+type awsIAMPolicyStatement struct {
+	Sid       string                            `json:"Sid,omitempty"`
+	Effect    string                            `json:"Effect"`
+	Principal map[string]string                 `json:"Principal,omitempty"`
+	Action    []string                          `json:"Action"`
+	Resource  []string                          `json:"Resource,omitempty"`
+	Condition map[string]map[string]interface{} `json:"Condition,omitempty"`
+}
+
+func dataSourceWizAwsIAMPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Render the AWS IAM trust and permission policies of the roles used by `wiz_connector_aws` and `wiz_outpost_aws`. The policy documents are embedded in the provider and rendered locally, no request is sent to Wiz.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal identifier for the data.",
+			},
+			"wiz_principal_arn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ARN of the Wiz principal allowed to assume the roles, as shown in the Wiz portal when adding an AWS connector.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(
+						regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:(root|role/.+)$`),
+						"must be an AWS account root or role ARN",
+					),
+				),
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The external ID Wiz presents when assuming the roles, as shown in the Wiz portal when adding an AWS connector.",
+			},
+			"deployment_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "STANDARD",
+				Description: fmt.Sprintf(
+					"Whether disk scanning runs in Wiz (`STANDARD`) or in an Outpost. `OUTPOST` shares the snapshots with `outpost_account_id` and renders the orchestrator role policies.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						awsIAMPolicyDeploymentType,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						awsIAMPolicyDeploymentType,
+						false,
+					),
+				),
+			},
+			"outpost_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The AWS account ID the Outpost is deployed to, required when `deployment_type` is `OUTPOST`.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(
+						regexp.MustCompile(`^\d{12}$`),
+						"must be an AWS account ID",
+					),
+				),
+			},
+			"outpost_external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The external ID Wiz presents when assuming the Outpost orchestrator role, the `external_id` of `wiz_outpost_aws`, required when `deployment_type` is `OUTPOST`.",
+			},
+			"disk_scanning_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to grant the permissions to snapshot and scan volumes.",
+			},
+			"data_scanning_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to grant the permissions to scan data in S3 buckets and RDS snapshots.",
+			},
+			"cloudtrail_bucket_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the CloudTrail bucket read by Wiz Cloud Events.",
+			},
+			"organization_wide": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the role is deployed to the organization management account to connect the whole organization, grants the permissions to read the organization structure.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "WizAccess-Role",
+				Description: "The role name used in the CloudFormation template.",
+			},
+			"policy_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the rendered policy documents, a digest of the documents embedded in the provider that changes whenever a document changes.",
+			},
+			"trust_policy_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The trust policy of the customer role, use it as the `assume_role_policy` of an `aws_iam_role`.",
+			},
+			"managed_policy_arns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The AWS managed policies to attach to the customer role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"permission_policies": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The inline policies of the customer role, keyed by policy name.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"orchestrator_trust_policy_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The trust policy of the Outpost orchestrator role, only set when `deployment_type` is `OUTPOST`.",
+			},
+			"orchestrator_policy_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The permission policy of the Outpost orchestrator role, only set when `deployment_type` is `OUTPOST`.",
+			},
+			"cloudformation_template_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A CloudFormation template creating the customer role, also usable as a StackSet template to deploy the role to every account when `organization_wide` is set.",
+			},
+		},
+		ReadContext: dataSourceWizAwsIAMPoliciesRead,
+	}
+}
+
+// awsIAMPartition returns the partition of an ARN
+func awsIAMPartition(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 2 || parts[1] == "" {
+		return "aws"
+	}
+
+	return parts[1]
+}
+
+// awsIAMPolicyVariables returns the variables of the policy documents
+func awsIAMPolicyVariables(d *schema.ResourceData) map[string]string {
+	return map[string]string{
+		"partition":              awsIAMPartition(d.Get("wiz_principal_arn").(string)),
+		"wiz_principal_arn":      d.Get("wiz_principal_arn").(string),
+		"external_id":            d.Get("external_id").(string),
+		"outpost_account_id":     d.Get("outpost_account_id").(string),
+		"outpost_external_id":    d.Get("outpost_external_id").(string),
+		"cloudtrail_bucket_name": d.Get("cloudtrail_bucket_name").(string),
+	}
+}
+
+// awsIAMPolicy renders an embedded AWS policy document
+func awsIAMPolicy(name string, variables map[string]string) (awsIAMPolicyDocument, error) {
+	var policy awsIAMPolicyDocument
+	err := readPolicyDocument("aws", name, variables, &policy)
+
+	return policy, err
+}
+
+// awsIAMPermissionPolicies returns the inline policies of the customer role for the deployment options
+func awsIAMPermissionPolicies(d *schema.ResourceData) (map[string]awsIAMPolicyDocument, error) {
+	names := []string{"WizFullPolicy"}
+	if d.Get("disk_scanning_enabled").(bool) {
+		names = append(names, "WizDiskScanningPolicy")
+	}
+	if d.Get("data_scanning_enabled").(bool) {
+		names = append(names, "WizDataScanningPolicy")
+	}
+	if d.Get("cloudtrail_bucket_name").(string) != "" {
+		names = append(names, "WizCloudTrailBucketPolicy")
+	}
+	if d.Get("organization_wide").(bool) {
+		names = append(names, "WizOrganizationPolicy")
+	}
+
+	variables := awsIAMPolicyVariables(d)
+	policies := make(map[string]awsIAMPolicyDocument, len(names))
+	for _, name := range names {
+		policy, err := awsIAMPolicy(name+".json", variables)
+		if err != nil {
+			return nil, err
+		}
+		policies[name] = policy
+	}
+
+	// an outpost deployment shares the snapshots with the outpost account instead of Wiz
+	if disk, ok := policies["WizDiskScanningPolicy"]; ok && d.Get("deployment_type").(string) == "OUTPOST" {
+		outpost, err := awsIAMPolicy("WizDiskScanningOutpostPolicy.json", variables)
+		if err != nil {
+			return nil, err
+		}
+		disk.Statement = append(disk.Statement, outpost.Statement...)
+		policies["WizDiskScanningPolicy"] = disk
+	}
+
+	return policies, nil
+}
+
+// awsIAMCloudFormationTemplate returns a CloudFormation template creating the customer role
+func awsIAMCloudFormationTemplate(version string, roleName string, trustPolicy awsIAMPolicyDocument, managedPolicyARNs []string, policies map[string]awsIAMPolicyDocument) map[string]interface{} {
+	names := make([]string, 0, len(policies))
+	for name := range policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var inlinePolicies []interface{}
+	for _, name := range names {
+		inlinePolicies = append(inlinePolicies, map[string]interface{}{
+			"PolicyName":     name,
+			"PolicyDocument": policies[name],
+		})
+	}
+
+	return map[string]interface{}{
+		"AWSTemplateFormatVersion": "2010-09-09",
+		"Description":              fmt.Sprintf("Wiz customer role, policy version %s", version),
+		"Resources": map[string]interface{}{
+			"WizAccessRole": map[string]interface{}{
+				"Type": "AWS::IAM::Role",
+				"Properties": map[string]interface{}{
+					"RoleName":                 roleName,
+					"AssumeRolePolicyDocument": trustPolicy,
+					"ManagedPolicyArns":        managedPolicyARNs,
+					"Policies":                 inlinePolicies,
+				},
+			},
+		},
+		"Outputs": map[string]interface{}{
+			"RoleARN": map[string]interface{}{
+				"Value": map[string]interface{}{
+					"Fn::GetAtt": []string{"WizAccessRole", "Arn"},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWizAwsIAMPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizAwsIAMPoliciesRead called...")
+
+	deploymentType := d.Get("deployment_type").(string)
+	if deploymentType == "OUTPOST" {
+		for _, attribute := range []string{"outpost_account_id", "outpost_external_id"} {
+			if d.Get(attribute).(string) == "" {
+				return append(diags, diag.Errorf("%s is required when deployment_type is OUTPOST", attribute)...)
+			}
+		}
+	}
+
+	version, err := policyDocumentsVersion("aws")
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	variables := awsIAMPolicyVariables(d)
+	trustPolicy, err := awsIAMPolicy("trust.json", variables)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	managedPolicyARNs := []string{
+		fmt.Sprintf("arn:%s:iam::aws:policy/SecurityAudit", variables["partition"]),
+		fmt.Sprintf("arn:%s:iam::aws:policy/job-function/ViewOnlyAccess", variables["partition"]),
+	}
+	policies, err := awsIAMPermissionPolicies(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	trustPolicyJSON, err := json.Marshal(trustPolicy)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	permissionPolicies := make(map[string]interface{}, len(policies))
+	for name, policy := range policies {
+		policyJSON, err := json.Marshal(policy)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		permissionPolicies[name] = string(policyJSON)
+	}
+
+	// the orchestrator role trusts the outpost external id, not the one of the connector
+	var orchestratorTrustPolicyJSON, orchestratorPolicyJSON []byte
+	if deploymentType == "OUTPOST" {
+		orchestratorTrustPolicy, err := awsIAMPolicy("orchestrator_trust.json", variables)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		orchestratorTrustPolicyJSON, err = json.Marshal(orchestratorTrustPolicy)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		orchestratorPolicy, err := awsIAMPolicy("orchestrator.json", variables)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		orchestratorPolicyJSON, err = json.Marshal(orchestratorPolicy)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	cloudFormationTemplateJSON, err := json.Marshal(awsIAMCloudFormationTemplate(version, d.Get("role_name").(string), trustPolicy, managedPolicyARNs, policies))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// the id is derived from the rendered documents
+	var identifier bytes.Buffer
+	identifier.WriteString(version)
+	identifier.Write(cloudFormationTemplateJSON)
+	identifier.Write(orchestratorTrustPolicyJSON)
+	identifier.Write(orchestratorPolicyJSON)
+	h := sha1.New()
+	h.Write(identifier.Bytes())
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	err = d.Set("policy_version", version)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("trust_policy_json", string(trustPolicyJSON))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("managed_policy_arns", utils.ConvertSliceToGenericArray(managedPolicyARNs))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("permission_policies", permissionPolicies)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("orchestrator_trust_policy_json", string(orchestratorTrustPolicyJSON))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("orchestrator_policy_json", string(orchestratorPolicyJSON))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("cloudformation_template_json", string(cloudFormationTemplateJSON))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAwsIAMTrustPolicy(t *testing.T) {
+	expected := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::197171649850:root"},"Action":["sts:AssumeRole"],"Condition":{"StringEquals":{"sts:ExternalId":"example-external-id"}}}]}`
+
+	trustPolicy, err := awsIAMPolicy("trust.json", map[string]string{
+		"wiz_principal_arn": "arn:aws:iam::197171649850:root",
+		"external_id":       "example-external-id",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := json.Marshal(trustPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(actual) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			actual,
+			expected,
+		)
+	}
+}
+
+func TestDataSourceWizAwsIAMPoliciesRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceWizAwsIAMPolicies().Schema, map[string]interface{}{
+		"wiz_principal_arn":      "arn:aws-us-gov:iam::197171649850:root",
+		"external_id":            "example-external-id",
+		"deployment_type":        "OUTPOST",
+		"outpost_account_id":     "100000000009",
+		"outpost_external_id":    "example-outpost-external-id",
+		"data_scanning_enabled":  true,
+		"cloudtrail_bucket_name": "example-trail",
+		"organization_wide":      true,
+	})
+
+	diags := dataSourceWizAwsIAMPoliciesRead(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expectedPolicies := []string{
+		"WizCloudTrailBucketPolicy",
+		"WizDataScanningPolicy",
+		"WizDiskScanningPolicy",
+		"WizFullPolicy",
+		"WizOrganizationPolicy",
+	}
+	var actualPolicies []string
+	for name := range d.Get("permission_policies").(map[string]interface{}) {
+		actualPolicies = append(actualPolicies, name)
+	}
+	sort.Strings(actualPolicies)
+
+	if !reflect.DeepEqual(actualPolicies, expectedPolicies) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actualPolicies,
+			expectedPolicies,
+		)
+	}
+
+	expectedManagedPolicyARNs := []interface{}{
+		"arn:aws-us-gov:iam::aws:policy/SecurityAudit",
+		"arn:aws-us-gov:iam::aws:policy/job-function/ViewOnlyAccess",
+	}
+	if !reflect.DeepEqual(d.Get("managed_policy_arns"), expectedManagedPolicyARNs) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			d.Get("managed_policy_arns"),
+			expectedManagedPolicyARNs,
+		)
+	}
+
+	expectedOrchestratorTrustPolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws-us-gov:iam::197171649850:root"},"Action":["sts:AssumeRole"],"Condition":{"StringEquals":{"sts:ExternalId":"example-outpost-external-id"}}}]}`
+	if d.Get("orchestrator_trust_policy_json").(string) != expectedOrchestratorTrustPolicy {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			d.Get("orchestrator_trust_policy_json"),
+			expectedOrchestratorTrustPolicy,
+		)
+	}
+
+	// the orchestrator policy is scoped to the outpost account and never grants a whole service
+	var orchestratorPolicy awsIAMPolicyDocument
+	err := json.Unmarshal([]byte(d.Get("orchestrator_policy_json").(string)), &orchestratorPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, statement := range orchestratorPolicy.Statement {
+		for _, action := range statement.Action {
+			if strings.HasSuffix(action, ":*") {
+				t.Fatalf("unexpected wildcard action %s in statement %s", action, statement.Sid)
+			}
+		}
+		for _, resource := range statement.Resource {
+			if strings.HasPrefix(resource, "arn:") && !strings.HasPrefix(resource, "arn:aws-us-gov:") {
+				t.Fatalf("unexpected partition in resource %s of statement %s", resource, statement.Sid)
+			}
+		}
+	}
+
+	for _, missing := range []string{"outpost_account_id", "outpost_external_id"} {
+		config := map[string]interface{}{
+			"wiz_principal_arn":   "arn:aws:iam::197171649850:root",
+			"external_id":         "example-external-id",
+			"deployment_type":     "OUTPOST",
+			"outpost_account_id":  "100000000009",
+			"outpost_external_id": "example-outpost-external-id",
+		}
+		delete(config, missing)
+		d = schema.TestResourceDataRaw(t, dataSourceWizAwsIAMPolicies().Schema, config)
+
+		diags = dataSourceWizAwsIAMPoliciesRead(context.Background(), d, nil)
+		if !diags.HasError() {
+			t.Fatalf("expected an error for an outpost deployment without %s", missing)
+		}
+	}
+}
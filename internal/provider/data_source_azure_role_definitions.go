@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+// azureMicrosoftGraphAppID is the application ID of Microsoft Graph, the same in every tenant
+const azureMicrosoftGraphAppID = "00000003-0000-0000-c000-000000000000"
+
+// azureRoleDefinition is an Azure custom role definition, in the format of the az role definition create command
+// https://learn.microsoft.com/en-us/azure/role-based-access-control/custom-roles
+type azureRoleDefinition struct {
+	Name        string   `json:"Name"`
+	Description string   `json:"Description"`
+	Actions     []string `json:"Actions"`
+	DataActions []string `json:"DataActions"`
+}
+
+// azureGraphAPIPermission is a Microsoft Graph application permission, the IDs are listed in the permissions reference
+// https://learn.microsoft.com/en-us/graph/permissions-reference
+type azureGraphAPIPermission struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+func dataSourceWizAzureRoleDefinitions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Render the Azure role definitions and Microsoft Graph permissions required by `wiz_connector_azure`. The definitions are embedded in the provider and rendered locally, no request is sent to Wiz.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal identifier for the data.",
+			},
+			"disk_scanning_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to grant the permissions to snapshot and scan disks.",
+			},
+			"data_scanning_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to grant the permissions to scan data in storage accounts.",
+			},
+			"entra_id_scanning_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to grant the Microsoft Graph permissions to scan Entra ID.",
+			},
+			"policy_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the rendered role definitions and Microsoft Graph permissions, a digest of the definitions embedded in the provider.",
+			},
+			"built_in_roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The built-in roles to assign to the Wiz service principal, use them as the `role_definition_name` of an `azurerm_role_assignment`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"role_definitions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The custom roles to create and assign to the Wiz service principal, use them as the `permissions` of an `azurerm_role_definition`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role name.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role description.",
+						},
+						"actions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The allowed control plane actions.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"data_actions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The allowed data plane actions.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"graph_app_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The application ID of Microsoft Graph, use it as the `resource_app_id` of the `required_resource_access` of an `azuread_application`.",
+			},
+			"graph_api_permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Microsoft Graph application permissions to grant to the Wiz application, only set when `entra_id_scanning_enabled` is set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The app role ID of the permission.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The permission name.",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceWizAzureRoleDefinitionsRead,
+	}
+}
+
+// azureRoleDefinitions returns the custom role definitions for the enabled features
+func azureRoleDefinitions(d *schema.ResourceData) ([]azureRoleDefinition, error) {
+	names := []string{"WizCustomRole"}
+	if d.Get("disk_scanning_enabled").(bool) {
+		names = append(names, "WizDiskAnalyzerRole")
+	}
+	if d.Get("data_scanning_enabled").(bool) {
+		names = append(names, "WizDataScannerRole")
+	}
+
+	definitions := make([]azureRoleDefinition, 0, len(names))
+	for _, name := range names {
+		var definition azureRoleDefinition
+		err := readPolicyDocument("azure", name+".json", nil, &definition)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, nil
+}
+
+// azureGraphAPIPermissions returns the Microsoft Graph application permissions for the enabled features
+func azureGraphAPIPermissions(d *schema.ResourceData) ([]azureGraphAPIPermission, error) {
+	if !d.Get("entra_id_scanning_enabled").(bool) {
+		return nil, nil
+	}
+
+	var permissions []azureGraphAPIPermission
+	err := readPolicyDocument("azure", "graph_api_permissions.json", nil, &permissions)
+
+	return permissions, err
+}
+
+func flattenAzureRoleDefinitions(definitions []azureRoleDefinition) []interface{} {
+	var output = make([]interface{}, 0, len(definitions))
+	for _, definition := range definitions {
+		output = append(output, map[string]interface{}{
+			"name":         definition.Name,
+			"description":  definition.Description,
+			"actions":      utils.ConvertSliceToGenericArray(definition.Actions),
+			"data_actions": utils.ConvertSliceToGenericArray(definition.DataActions),
+		})
+	}
+
+	return output
+}
+
+func flattenAzureGraphAPIPermissions(permissions []azureGraphAPIPermission) []interface{} {
+	var output = make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		output = append(output, map[string]interface{}{
+			"id":    permission.ID,
+			"value": permission.Value,
+		})
+	}
+
+	return output
+}
+
+func dataSourceWizAzureRoleDefinitionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizAzureRoleDefinitionsRead called...")
+
+	version, err := policyDocumentsVersion("azure")
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	builtInRoles := []string{"Reader"}
+	definitions, err := azureRoleDefinitions(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	permissions, err := azureGraphAPIPermissions(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// the id is derived from the rendered definitions
+	var identifier bytes.Buffer
+	identifier.WriteString(version)
+	identifier.WriteString(utils.PrettyPrint(builtInRoles))
+	identifier.WriteString(utils.PrettyPrint(definitions))
+	identifier.WriteString(utils.PrettyPrint(permissions))
+	h := sha1.New()
+	h.Write(identifier.Bytes())
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	err = d.Set("policy_version", version)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("built_in_roles", utils.ConvertSliceToGenericArray(builtInRoles))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("role_definitions", flattenAzureRoleDefinitions(definitions))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("graph_app_id", azureMicrosoftGraphAppID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("graph_api_permissions", flattenAzureGraphAPIPermissions(permissions))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
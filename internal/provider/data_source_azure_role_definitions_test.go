@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenAzureRoleDefinitions(t *testing.T) {
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":        "WizDataScannerRole",
+			"description": "Allows Wiz to scan data in storage accounts.",
+			"actions": []interface{}{
+				"Microsoft.Storage/storageAccounts/read",
+			},
+			"data_actions": []interface{}{
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+			},
+		},
+	}
+
+	definitions := []azureRoleDefinition{
+		{
+			Name:        "WizDataScannerRole",
+			Description: "Allows Wiz to scan data in storage accounts.",
+			Actions: []string{
+				"Microsoft.Storage/storageAccounts/read",
+			},
+			DataActions: []string{
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+			},
+		},
+	}
+
+	actual := flattenAzureRoleDefinitions(definitions)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actual,
+			expected,
+		)
+	}
+}
+
+func TestFlattenAzureGraphAPIPermissions(t *testing.T) {
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":    "7ab1d382-f21e-4acd-a863-ba3e13f7da61",
+			"value": "Directory.Read.All",
+		},
+	}
+
+	permissions := []azureGraphAPIPermission{
+		{
+			ID:    "7ab1d382-f21e-4acd-a863-ba3e13f7da61",
+			Value: "Directory.Read.All",
+		},
+	}
+
+	actual := flattenAzureGraphAPIPermissions(permissions)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actual,
+			expected,
+		)
+	}
+}
+
+func TestDataSourceWizAzureRoleDefinitionsRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceWizAzureRoleDefinitions().Schema, map[string]interface{}{
+		"disk_scanning_enabled":     false,
+		"data_scanning_enabled":     true,
+		"entra_id_scanning_enabled": false,
+	})
+
+	diags := dataSourceWizAzureRoleDefinitionsRead(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expectedNames := []string{"WizCustomRole", "WizDataScannerRole"}
+	var actualNames []string
+	for _, definition := range d.Get("role_definitions").([]interface{}) {
+		actualNames = append(actualNames, definition.(map[string]interface{})["name"].(string))
+	}
+
+	if !reflect.DeepEqual(actualNames, expectedNames) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actualNames,
+			expectedNames,
+		)
+	}
+
+	if len(d.Get("graph_api_permissions").([]interface{})) != 0 {
+		t.Fatal("expected no Microsoft Graph permissions when Entra ID scanning is disabled")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -452,3 +453,95 @@ func flattenSourceConnectorIDs(ctx context.Context, connectors *[]wiz.Connector)
 
 	return output
 }
+
+// matchCloudAccountByExternalID returns the Wiz identifier of the only cloud account with the external identifier,
+// the cloud accounts search is free text so the results are matched exactly
+func matchCloudAccountByExternalID(externalID string, cloudAccounts []*wiz.CloudAccount) (string, error) {
+	var matches []*wiz.CloudAccount
+	for _, cloudAccount := range cloudAccounts {
+		if strings.EqualFold(cloudAccount.ExternalID, externalID) {
+			matches = append(matches, cloudAccount)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no cloud account found with external ID %q", externalID)
+	case 1:
+		return matches[0].ID, nil
+	}
+
+	var candidates []string
+	for _, match := range matches {
+		candidates = append(candidates, fmt.Sprintf("%s (%s %s)", match.ID, match.CloudProvider, match.Name))
+	}
+	return "", fmt.Errorf("external ID %q matches %d cloud accounts, use the Wiz identifier instead: %s", externalID, len(matches), strings.Join(candidates, ", "))
+}
+
+// resolveCloudAccountExternalIDs returns the Wiz identifiers of the cloud accounts keyed by their external identifier (AWS account ID, GCP project ID, Azure subscription ID)
+func resolveCloudAccountExternalIDs(ctx context.Context, m interface{}, externalIDs []string) (map[string]string, diag.Diagnostics) {
+	tflog.Info(ctx, "resolveCloudAccountExternalIDs called...")
+
+	var diags diag.Diagnostics
+	resolved := make(map[string]string)
+
+	// define the graphql query
+	query := `query cloudAccounts(
+	  $filterBy: CloudAccountFilters
+	  $first: Int
+	  $after: String
+	) {
+	  cloudAccounts(
+	    filterBy: $filterBy
+	    first: $first
+	    after: $after
+	  ) {
+	    nodes {
+	      id
+	      externalId
+	      name
+	      cloudProvider
+	    }
+	    pageInfo {
+	      hasNextPage
+	      endCursor
+	    }
+	  }
+	}`
+
+	for _, externalID := range utils.Unique(externalIDs) {
+		if externalID == "" {
+			continue
+		}
+
+		// populate the graphql variables
+		vars := &internal.QueryVariables{}
+		vars.First = 500
+		vars.FilterBy = &wiz.CloudAccountFilters{
+			Search: []string{externalID},
+		}
+
+		// process the request
+		data := &ReadCloudAccounts{}
+		requestDiags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "cloud_accounts", "read", 0)
+		diags = append(diags, requestDiags...)
+		if len(diags) > 0 {
+			return nil, diags
+		}
+
+		var cloudAccounts []*wiz.CloudAccount
+		for _, page := range allData {
+			cloudAccounts = append(cloudAccounts, page.(*ReadCloudAccounts).CloudAccounts.Nodes...)
+		}
+
+		id, err := matchCloudAccountByExternalID(externalID, cloudAccounts)
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+		resolved[externalID] = id
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("resolveCloudAccountExternalIDs output: %s", utils.PrettyPrint(resolved)))
+
+	return resolved, diags
+}
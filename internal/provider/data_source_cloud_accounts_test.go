@@ -129,3 +129,55 @@ func TestFlattenSourceConnectorIDs(t *testing.T) {
 		)
 	}
 }
+
+func TestMatchCloudAccountByExternalID(t *testing.T) {
+	cloudAccounts := []*wiz.CloudAccount{
+		{
+			ID:            "3bc53af8-6661-4a57-a2ee-69a4f2853bba",
+			ExternalID:    "100000000009",
+			Name:          "production",
+			CloudProvider: "AWS",
+		},
+		{
+			ID:            "6b0e7f6c-3b7e-4f8a-9c8e-2f5e1c1d0a11",
+			ExternalID:    "1000000000090",
+			Name:          "production-100000000009",
+			CloudProvider: "AWS",
+		},
+		{
+			ID:            "0b7a1f5e-2c4d-4e6f-8a9b-1c2d3e4f5a6b",
+			ExternalID:    "shared-project",
+			Name:          "shared",
+			CloudProvider: "GCP",
+		},
+		{
+			ID:            "9d8c7b6a-5f4e-4d3c-2b1a-0f9e8d7c6b5a",
+			ExternalID:    "shared-project",
+			Name:          "shared",
+			CloudProvider: "OCI",
+		},
+	}
+
+	expected := "3bc53af8-6661-4a57-a2ee-69a4f2853bba"
+	actual, err := matchCloudAccountByExternalID("100000000009", cloudAccounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			actual,
+			expected,
+		)
+	}
+
+	_, err = matchCloudAccountByExternalID("100000000010", cloudAccounts)
+	if err == nil {
+		t.Fatal("expected an error for an external ID without cloud account")
+	}
+
+	_, err = matchCloudAccountByExternalID("shared-project", cloudAccounts)
+	if err == nil {
+		t.Fatal("expected an error for an external ID matching several cloud accounts")
+	}
+}
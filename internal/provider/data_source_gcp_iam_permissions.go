@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+// gcpIAMPermissionsScope enum
+var gcpIAMPermissionsScope = []string{
+	"PROJECT",
+	"FOLDER",
+	"ORGANIZATION",
+}
+
+// gcpIAMRole is a GCP custom role, in the format of the gcloud iam roles create command
+// https://cloud.google.com/iam/docs/creating-custom-roles
+type gcpIAMRole struct {
+	Title               string   `json:"title"`
+	Description         string   `json:"description"`
+	Stage               string   `json:"stage"`
+	IncludedPermissions []string `json:"includedPermissions"`
+}
+
+// gcpIAMServices are the APIs to enable, keyed by the feature requiring them
+type gcpIAMServices struct {
+	Standard        []string `json:"standard"`
+	DataScanning    []string `json:"dataScanning"`
+	AuditLogMonitor []string `json:"auditLogMonitor"`
+}
+
+func dataSourceWizGcpIAMPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Render the GCP IAM roles, custom role permissions and APIs required by `wiz_connector_gcp`. The permissions are embedded in the provider and rendered locally, no request is sent to Wiz.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal identifier for the data.",
+			},
+			"wiz_service_account_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The email of the Wiz service account granted the roles, as shown in the Wiz portal when adding a GCP connector.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(
+						regexp.MustCompile(`^[^@\s]+@[a-z0-9-]+\.iam\.gserviceaccount\.com$`),
+						"must be a service account email",
+					),
+				),
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "PROJECT",
+				Description: fmt.Sprintf(
+					"The level of the resource hierarchy the roles are granted at.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						gcpIAMPermissionsScope,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						gcpIAMPermissionsScope,
+						false,
+					),
+				),
+			},
+			"disk_scanning_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to grant the permissions to snapshot and scan disks.",
+			},
+			"data_scanning_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to grant the permissions to scan data in Cloud Storage buckets and Cloud SQL backups.",
+			},
+			"audit_log_monitor_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether audit logs are routed to a Pub/Sub topic read by Wiz Cloud Events.",
+			},
+			"custom_role_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "wizCustomRole",
+				Description: "The ID of the custom role, used in the custom role binding.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(
+						regexp.MustCompile(`^[a-zA-Z0-9_.]{3,64}$`),
+						"must be 3 to 64 letters, digits, underscores or periods",
+					),
+				),
+			},
+			"policy_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the rendered roles, permissions and APIs, a digest of the role files embedded in the provider.",
+			},
+			"member": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM member of the Wiz service account, use it as the `member` of the IAM member resources.",
+			},
+			"custom_role_permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The permissions of the custom role, use them as the `permissions` of a `google_project_iam_custom_role` or `google_organization_iam_custom_role`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"bindings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The predefined roles to grant to the Wiz service account at `scope`. The custom role is granted separately, as its name depends on where it is created.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role to grant.",
+						},
+						"member": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The member the role is granted to.",
+						},
+					},
+				},
+			},
+			"required_services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The APIs to enable in the scanned projects, use them as the `service` of `google_project_service`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"audit_log_sink_filter": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The filter of the logging sink routing audit logs to the Pub/Sub topic, only set when `audit_log_monitor_enabled` is set.",
+			},
+			"pubsub_subscription_roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The roles to grant to the Wiz service account on the Pub/Sub subscription, only set when `audit_log_monitor_enabled` is set.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: dataSourceWizGcpIAMPermissionsRead,
+	}
+}
+
+// gcpIAMCustomRolePermissions returns the permissions of the custom role for the enabled features
+func gcpIAMCustomRolePermissions(d *schema.ResourceData) ([]string, error) {
+	names := []string{"wizCustomRole"}
+	if d.Get("disk_scanning_enabled").(bool) {
+		names = append(names, "wizDiskScanningRole")
+	}
+	if d.Get("data_scanning_enabled").(bool) {
+		names = append(names, "wizDataScanningRole")
+	}
+
+	var permissions []string
+	for _, name := range names {
+		var role gcpIAMRole
+		err := readPolicyDocument("gcp", name+".json", nil, &role)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, role.IncludedPermissions...)
+	}
+
+	return permissions, nil
+}
+
+// gcpIAMPredefinedRoles returns the predefined roles granted at the scope
+func gcpIAMPredefinedRoles(scope string) ([]string, error) {
+	var roles map[string][]string
+	err := readPolicyDocument("gcp", "predefined_roles.json", nil, &roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles[scope], nil
+}
+
+// gcpIAMRequiredServices returns the APIs to enable for the enabled features
+func gcpIAMRequiredServices(d *schema.ResourceData) ([]string, error) {
+	var services gcpIAMServices
+	err := readPolicyDocument("gcp", "required_services.json", nil, &services)
+	if err != nil {
+		return nil, err
+	}
+
+	required := services.Standard
+	if d.Get("data_scanning_enabled").(bool) {
+		required = append(required, services.DataScanning...)
+	}
+	if d.Get("audit_log_monitor_enabled").(bool) {
+		required = append(required, services.AuditLogMonitor...)
+	}
+
+	return required, nil
+}
+
+func dataSourceWizGcpIAMPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizGcpIAMPermissionsRead called...")
+
+	version, err := policyDocumentsVersion("gcp")
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	member := fmt.Sprintf("serviceAccount:%s", d.Get("wiz_service_account_email").(string))
+	permissions, err := gcpIAMCustomRolePermissions(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	roles, err := gcpIAMPredefinedRoles(d.Get("scope").(string))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	services, err := gcpIAMRequiredServices(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	var bindings []interface{}
+	for _, role := range roles {
+		bindings = append(bindings, map[string]interface{}{
+			"role":   role,
+			"member": member,
+		})
+	}
+
+	var auditLogSinkFilter string
+	var subscriptionRoles []string
+	if d.Get("audit_log_monitor_enabled").(bool) {
+		auditLogSinkFilter = `logName:"/logs/cloudaudit.googleapis.com"`
+		subscriptionRoles = []string{"roles/pubsub.subscriber"}
+	}
+
+	// the id is derived from the rendered permissions
+	var identifier bytes.Buffer
+	identifier.WriteString(version)
+	identifier.WriteString(member)
+	identifier.WriteString(utils.PrettyPrint(permissions))
+	identifier.WriteString(utils.PrettyPrint(roles))
+	identifier.WriteString(utils.PrettyPrint(services))
+	identifier.WriteString(auditLogSinkFilter)
+	h := sha1.New()
+	h.Write(identifier.Bytes())
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	err = d.Set("policy_version", version)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("member", member)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("custom_role_permissions", utils.ConvertSliceToGenericArray(permissions))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("bindings", bindings)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("required_services", utils.ConvertSliceToGenericArray(services))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("audit_log_sink_filter", auditLogSinkFilter)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("pubsub_subscription_roles", utils.ConvertSliceToGenericArray(subscriptionRoles))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
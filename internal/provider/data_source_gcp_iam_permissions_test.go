@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGcpIAMPredefinedRoles(t *testing.T) {
+	expected := []string{
+		"roles/browser",
+		"roles/cloudasset.viewer",
+		"roles/iam.securityReviewer",
+		"roles/serviceusage.serviceUsageConsumer",
+		"roles/viewer",
+		"roles/resourcemanager.folderViewer",
+		"roles/resourcemanager.organizationViewer",
+	}
+
+	actual, err := gcpIAMPredefinedRoles("ORGANIZATION")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actual,
+			expected,
+		)
+	}
+}
+
+func TestDataSourceWizGcpIAMPermissionsRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceWizGcpIAMPermissions().Schema, map[string]interface{}{
+		"wiz_service_account_email": "wiz-scanner@wiz-project.iam.gserviceaccount.com",
+		"disk_scanning_enabled":     false,
+		"audit_log_monitor_enabled": true,
+	})
+
+	diags := dataSourceWizGcpIAMPermissionsRead(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expectedBinding := map[string]interface{}{
+		"role":   "roles/browser",
+		"member": "serviceAccount:wiz-scanner@wiz-project.iam.gserviceaccount.com",
+	}
+	if !reflect.DeepEqual(d.Get("bindings.0"), expectedBinding) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			d.Get("bindings.0"),
+			expectedBinding,
+		)
+	}
+
+	for _, permission := range d.Get("custom_role_permissions").([]interface{}) {
+		if permission == "compute.snapshots.create" {
+			t.Fatal("expected no disk scanning permissions when disk scanning is disabled")
+		}
+	}
+
+	expectedSubscriptionRoles := []interface{}{"roles/pubsub.subscriber"}
+	if !reflect.DeepEqual(d.Get("pubsub_subscription_roles"), expectedSubscriptionRoles) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			d.Get("pubsub_subscription_roles"),
+			expectedSubscriptionRoles,
+		)
+	}
+}
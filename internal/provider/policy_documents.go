@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+// policyDocuments holds the policy documents rendered by the IAM data sources, one directory per cloud
+//
+//go:embed policies
+var policyDocuments embed.FS
+
+// policyDocumentVariable matches the ${name} placeholders of a policy document
+var policyDocumentVariable = regexp.MustCompile(`\$\{([a-z_]+)\}`)
+
+// policyDocumentsVersion returns the version of the documents of a cloud, derived from their content so it changes whenever a document changes
+func policyDocumentsVersion(cloud string) (string, error) {
+	dir := path.Join("policies", cloud)
+	entries, err := fs.ReadDir(policyDocuments, dir)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		content, err := policyDocuments.ReadFile(path.Join(dir, name))
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte(name))
+		h.Write(content)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))[:12], nil
+}
+
+// readPolicyDocument renders an embedded document of a cloud into v, the placeholders are replaced with the JSON escaped variables
+func readPolicyDocument(cloud string, name string, variables map[string]string, v interface{}) error {
+	content, err := policyDocuments.ReadFile(path.Join("policies", cloud, name))
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	rendered := policyDocumentVariable.ReplaceAllFunc(content, func(match []byte) []byte {
+		variable := string(policyDocumentVariable.FindSubmatch(match)[1])
+		value, ok := variables[variable]
+		if !ok {
+			missing = append(missing, variable)
+			return match
+		}
+		escaped, _ := json.Marshal(value)
+		return escaped[1 : len(escaped)-1]
+	})
+	if len(missing) > 0 {
+		return fmt.Errorf("policy document %s/%s is missing the variables %s", cloud, name, strings.Join(utils.Unique(missing), ", "))
+	}
+
+	return json.Unmarshal(rendered, v)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadPolicyDocument(t *testing.T) {
+	expected := []string{
+		`arn:aws:s3:::example"bucket`,
+		`arn:aws:s3:::example"bucket/*`,
+	}
+
+	var policy awsIAMPolicyDocument
+	err := readPolicyDocument("aws", "WizCloudTrailBucketPolicy.json", map[string]string{
+		"partition":              "aws",
+		"cloudtrail_bucket_name": `example"bucket`,
+	}, &policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(policy.Statement[0].Resource, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			policy.Statement[0].Resource,
+			expected,
+		)
+	}
+
+	err = readPolicyDocument("aws", "WizCloudTrailBucketPolicy.json", map[string]string{}, &policy)
+	if err == nil {
+		t.Fatal("expected an error for a policy document rendered without its variables")
+	}
+}
+
+func TestPolicyDocumentsVersion(t *testing.T) {
+	version, err := policyDocumentsVersion("aws")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(version) != 12 {
+		t.Fatalf("unexpected policy version %s", version)
+	}
+
+	// every cloud is versioned from its own documents
+	gcpVersion, err := policyDocumentsVersion("gcp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gcpVersion == version {
+		t.Fatalf("expected distinct policy versions, got %s for aws and gcp", version)
+	}
+
+	_, err = policyDocumentsVersion("unknown")
+	if err == nil {
+		t.Fatal("expected an error for a cloud without policy documents")
+	}
+}
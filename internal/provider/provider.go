@@ -270,8 +270,11 @@ yLyKQXhw2W2Xs0qLeC1etA+jTGDK4UfLeC0SF7FSi8o5LL21L8IzApar2pR/
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
+				"wiz_aws_iam_policies":             dataSourceWizAwsIAMPolicies(),
+				"wiz_azure_role_definitions":       dataSourceWizAzureRoleDefinitions(),
 				"wiz_cloud_accounts":               dataSourceWizCloudAccounts(),
 				"wiz_cloud_config_rules":           dataSourceWizCloudConfigurationRules(),
+				"wiz_gcp_iam_permissions":          dataSourceWizGcpIAMPermissions(),
 				"wiz_host_config_rules":            dataSourceWizHostConfigurationRules(),
 				"wiz_kubernetes_clusters":          dataSourceWizKubernetesClusters(),
 				"wiz_organizations":                dataSourceWizOrganizations(),
@@ -279,30 +282,69 @@ yLyKQXhw2W2Xs0qLeC1etA+jTGDK4UfLeC0SF7FSi8o5LL21L8IzApar2pR/
 				"wiz_users":                        dataSourceWizUsers(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"wiz_automation_rule_aws_sns":                  resourceWizAutomationRuleAwsSns(),
-				"wiz_automation_rule_servicenow_create_ticket": resourceWizAutomationRuleServiceNowCreateTicket(),
-				"wiz_automation_rule_servicenow_update_ticket": resourceWizAutomationRuleServiceNowUpdateTicket(),
-				"wiz_automation_rule_jira_transition_ticket":   resourceWizAutomationRuleJiraTransitionTicket(),
-				"wiz_automation_rule_jira_add_comment":         resourceWizAutomationRuleJiraAddComment(),
-				"wiz_automation_rule_jira_create_ticket":       resourceWizAutomationRuleJiraCreateTicket(),
-				"wiz_cicd_scan_policy":                         resourceWizCICDScanPolicy(),
-				"wiz_cloud_config_rule":                        resourceWizCloudConfigurationRule(),
-				"wiz_cloud_config_rule_associations":           resourceWizCloudConfigRuleAssociations(),
-				"wiz_control":                                  resourceWizControl(),
-				"wiz_control_associations":                     resourceWizControlAssociations(),
-				"wiz_connector_aws":                            resourceWizConnectorAws(),
-				"wiz_connector_gcp":                            resourceWizConnectorGcp(),
-				"wiz_host_config_rule_associations":            resourceWizHostConfigRuleAssociations(),
-				"wiz_integration_aws_sns":                      resourceWizIntegrationAwsSNS(),
-				"wiz_integration_servicenow":                   resourceWizIntegrationServiceNow(),
-				"wiz_integration_jira":                         resourceWizIntegrationJira(),
-				"wiz_report_graph_query":                       resourceWizReportGraphQuery(),
-				"wiz_project":                                  resourceWizProject(),
-				"wiz_saml_idp":                                 resourceWizSAMLIdP(),
-				"wiz_security_framework":                       resourceWizSecurityFramework(),
-				"wiz_service_account":                          resourceWizServiceAccount(),
-				"wiz_user":                                     resourceWizUser(),
-				"wiz_outpost_aws":                              resourceWizOutpostAWS(),
+				"wiz_automation_rule":                            resourceWizAutomationRule(),
+				"wiz_automation_rule_aws_sns":                    resourceWizAutomationRuleAwsSns(),
+				"wiz_automation_rule_azure_service_bus":          resourceWizAutomationRuleAzureServiceBus(),
+				"wiz_automation_rule_clickup_create_task":        resourceWizAutomationRuleClickUpCreateTask(),
+				"wiz_automation_rule_servicenow_create_ticket":   resourceWizAutomationRuleServiceNowCreateTicket(),
+				"wiz_automation_rule_servicenow_update_ticket":   resourceWizAutomationRuleServiceNowUpdateTicket(),
+				"wiz_automation_rule_jira_transition_ticket":     resourceWizAutomationRuleJiraTransitionTicket(),
+				"wiz_automation_rule_jira_add_comment":           resourceWizAutomationRuleJiraAddComment(),
+				"wiz_automation_rule_email":                      resourceWizAutomationRuleEmail(),
+				"wiz_automation_rule_gcp_pub_sub":                resourceWizAutomationRuleGcpPubSub(),
+				"wiz_automation_rule_google_chat":                resourceWizAutomationRuleGoogleChat(),
+				"wiz_automation_rule_jira_create_ticket":         resourceWizAutomationRuleJiraCreateTicket(),
+				"wiz_automation_rule_opsgenie_close_alert":       resourceWizAutomationRuleOpsgenieCloseAlert(),
+				"wiz_automation_rule_opsgenie_create_alert":      resourceWizAutomationRuleOpsgenieCreateAlert(),
+				"wiz_automation_rule_pagerduty_create_incident":  resourceWizAutomationRulePagerDutyCreateIncident(),
+				"wiz_automation_rule_pagerduty_resolve_incident": resourceWizAutomationRulePagerDutyResolveIncident(),
+				"wiz_automation_rule_slack":                      resourceWizAutomationRuleSlack(),
+				"wiz_automation_rule_slack_bot":                  resourceWizAutomationRuleSlackBot(),
+				"wiz_automation_rule_webhook":                    resourceWizAutomationRuleWebhook(),
+				"wiz_cicd_scan_policy":                           resourceWizCICDScanPolicy(),
+				"wiz_cloud_config_rule":                          resourceWizCloudConfigurationRule(),
+				"wiz_cloud_config_rule_associations":             resourceWizCloudConfigRuleAssociations(),
+				"wiz_control":                                    resourceWizControl(),
+				"wiz_control_associations":                       resourceWizControlAssociations(),
+				"wiz_connector_acr":                              resourceWizConnectorACR(),
+				"wiz_connector_alibaba":                          resourceWizConnectorAlibaba(),
+				"wiz_connector_aws":                              resourceWizConnectorAws(),
+				"wiz_connector_azure":                            resourceWizConnectorAzure(),
+				"wiz_connector_azure_devops":                     resourceWizConnectorAzureDevOps(),
+				"wiz_connector_bitbucket":                        resourceWizConnectorBitbucket(),
+				"wiz_connector_docker_hub":                       resourceWizConnectorDockerHub(),
+				"wiz_connector_ecr":                              resourceWizConnectorECR(),
+				"wiz_connector_gcp":                              resourceWizConnectorGcp(),
+				"wiz_connector_gcr":                              resourceWizConnectorGCR(),
+				"wiz_connector_github":                           resourceWizConnectorGitHub(),
+				"wiz_connector_gitlab":                           resourceWizConnectorGitLab(),
+				"wiz_connector_jfrog":                            resourceWizConnectorJFrog(),
+				"wiz_connector_kubernetes":                       resourceWizConnectorKubernetes(),
+				"wiz_connector_oci":                              resourceWizConnectorOci(),
+				"wiz_host_config_rule":                           resourceWizHostConfigurationRule(),
+				"wiz_host_config_rule_associations":              resourceWizHostConfigRuleAssociations(),
+				"wiz_integration_aws_sns":                        resourceWizIntegrationAwsSNS(),
+				"wiz_integration_azure_service_bus":              resourceWizIntegrationAzureServiceBus(),
+				"wiz_integration_clickup":                        resourceWizIntegrationClickUp(),
+				"wiz_integration_servicenow":                     resourceWizIntegrationServiceNow(),
+				"wiz_integration_gcp_pub_sub":                    resourceWizIntegrationGcpPubSub(),
+				"wiz_integration_jira":                           resourceWizIntegrationJira(),
+				"wiz_integration_opsgenie":                       resourceWizIntegrationOpsgenie(),
+				"wiz_integration_pagerduty":                      resourceWizIntegrationPagerDuty(),
+				"wiz_integration_slack":                          resourceWizIntegrationSlack(),
+				"wiz_integration_slack_bot":                      resourceWizIntegrationSlackBot(),
+				"wiz_integration_webhook":                        resourceWizIntegrationWebhook(),
+				"wiz_report_graph_query":                         resourceWizReportGraphQuery(),
+				"wiz_project":                                    resourceWizProject(),
+				"wiz_saml_idp":                                   resourceWizSAMLIdP(),
+				"wiz_security_framework":                         resourceWizSecurityFramework(),
+				"wiz_service_account":                            resourceWizServiceAccount(),
+				"wiz_user":                                       resourceWizUser(),
+				"wiz_outpost_aws":                                resourceWizOutpostAWS(),
+				"wiz_outpost_azure":                              resourceWizOutpostAzure(),
+				"wiz_outpost_cluster":                            resourceWizOutpostCluster(),
+				"wiz_outpost_gcp":                                resourceWizOutpostGCP(),
+				"wiz_outpost_oci":                                resourceWizOutpostOCI(),
 			},
 		}
 		p.ConfigureContextFunc = configure(version, p)
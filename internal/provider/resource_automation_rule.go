@@ -2,15 +2,30 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
 
+const (
+	// automationRuleDefaultTicketSummary is the default summary template for actions that create tickets
+	automationRuleDefaultTicketSummary = "Wiz Issue: {{control.name}}"
+	// automationRuleDefaultTicketDescription is the default description template for actions that create tickets
+	automationRuleDefaultTicketDescription = `Description:  {{issue.description}}\nStatus:       {{issue.status}}\nCreated:      {{issue.createdAt}}\nSeverity:     {{issue.severity}}\nProject:      {{#issue.projects}}{{name}}, {{/issue.projects}}\n\n---\nResource:\t            {{issue.entitySnapshot.name}}\nType:\t                {{issue.entitySnapshot.nativeType}}\nCloud Platform:\t        {{issue.entitySnapshot.cloudPlatform}}\nCloud Resource URL:     {{issue.entitySnapshot.cloudProviderURL}}\nSubscription Name (ID): {{issue.entitySnapshot.subscriptionName}} ({{issue.entitySnapshot.subscriptionExternalId}})\nRegion:\t                {{issue.entitySnapshot.region}}\nPlease click the following link to proceed to investigate the issue:\nhttps://{{wizDomain}}/issues#~(issue~'{{issue.id}})\nSource Automation Rule: {{ruleName}}`
+)
+
 // CreateAutomationRule struct
 type CreateAutomationRule struct {
 	CreateAutomationRule wiz.CreateAutomationRulePayload `json:"createAutomationRule"`
@@ -31,6 +46,1369 @@ type DeleteAutomationRule struct {
 	DeleteAutomationRule wiz.DeleteAutomationRulePayload `json:"deleteAutomationRule"`
 }
 
+// automationRuleActionTemplateTypes maps the action template blocks of wiz_automation_rule to their ActionTemplateType
+var automationRuleActionTemplateTypes = map[string]string{
+	"aws_sns":                    "AWS_SNS",
+	"azure_service_bus":          "AZURE_SERVICE_BUS",
+	"clickup_create_task":        "CLICK_UP_CREATE_TASK",
+	"email":                      "EMAIL",
+	"gcp_pub_sub":                "GCP_PUB_SUB",
+	"google_chat":                "GOOGLE_CHAT",
+	"jira_add_comment":           "JIRA_ADD_COMMENT",
+	"jira_create_ticket":         "JIRA_CREATE_TICKET",
+	"jira_transition_ticket":     "JIRA_TRANSITION_TICKET",
+	"opsgenie_close_alert":       "OPSGENIE_CLOSE_ALERT",
+	"opsgenie_create_alert":      "OPSGENIE_CREATE_ALERT",
+	"pagerduty_create_incident":  "PAGER_DUTY_CREATE_INCIDENT",
+	"pagerduty_resolve_incident": "PAGER_DUTY_RESOLVE_INCIDENT",
+	"servicenow_create_ticket":   "SERVICE_NOW_CREATE_TICKET",
+	"servicenow_update_ticket":   "SERVICE_NOW_UPDATE_TICKET",
+	"slack":                      "SLACK",
+	"slack_bot":                  "SLACK_BOT",
+	"webhook":                    "WEBHOOK",
+}
+
+// automationRuleActionTemplatesWithoutParams lists the action template blocks of wiz_automation_rule whose actions send no action template params
+var automationRuleActionTemplatesWithoutParams = map[string]bool{
+	"pagerduty_resolve_incident": true,
+}
+
+// automationRuleActionParamAliases maps aliased action template parameters in the read query to their field names
+// aliases are required because the ActionTemplateParams union members define fields with the same name but different types
+var automationRuleActionParamAliases = map[string]string{
+	"serviceNowUpdateFields": "fields",
+	"slackBotChannel":        "channel",
+	"transitionComment":      "comment",
+}
+
+func resourceWizAutomationRule() *schema.Resource {
+	ruleSchema := automationRuleSchema()
+	ruleSchema["action"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: "Actions to run when the automation rule is triggered. Each action must define exactly one action template block or `template_params_json`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Wiz internal ID for the action.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The action template type of `template_params_json`, for action template types without a block. Actions with an action template block derive their type from the block and must not set it.",
+				},
+				"template_params_json": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.StringIsJSON,
+					),
+					Description: "The action template parameters for action template types without a block, keyed by the ActionTemplateParamsInput field, e.g. `jsonencode({ teams = { note = \"...\" } })`. Value should be wrapped in jsonencode() to avoid diff detection. The API does not return the parameters in this format, so imported actions of these types leave it empty until it is set.",
+				},
+				"integration_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Wiz identifier for the Integration to leverage for this action. The integration type must match the action template block.",
+				},
+				"aws_sns": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Publish a message to AWS SNS. Requires a `wiz_integration_aws_sns` integration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "AWS SNS body.",
+							},
+						},
+					},
+				},
+				"azure_service_bus": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Send a message to Azure Service Bus.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:             schema.TypeString,
+								Required:         true,
+								Description:      "Azure Service Bus message body. Supports templated content; the template syntax is validated during plan.",
+								ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+							},
+						},
+					},
+				},
+				"clickup_create_task": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Create a ClickUp task.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"list_id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Identifier of the ClickUp list in which tasks are created.",
+							},
+							"body": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "ClickUp task body.",
+							},
+						},
+					},
+				},
+				"email": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Send an email.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"to": {
+								Type:        schema.TypeList,
+								Required:    true,
+								MinItems:    1,
+								Description: "Email recipients.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"cc": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Email CC recipients.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"note": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "Note to add to the email. Supports templated content; the template syntax is validated during plan.",
+								ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+							},
+							"attach_evidence_csv": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Upload issue evidence CSV as attachment?",
+							},
+						},
+					},
+				},
+				"gcp_pub_sub": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Publish a message to GCP Pub/Sub.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:             schema.TypeString,
+								Required:         true,
+								Description:      "GCP Pub/Sub message body. Supports templated content; the template syntax is validated during plan.",
+								ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+							},
+						},
+					},
+				},
+				"google_chat": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Send a Google Chat message.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"note": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "Note to add to the message. Supports templated content; the template syntax is validated during plan.",
+								ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+							},
+						},
+					},
+				},
+				"jira_add_comment": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Add a comment to a Jira ticket. Requires a `wiz_integration_jira` integration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"project_key": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Issue project",
+							},
+							"comment": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Issue Jira comment",
+							},
+							"add_issues_report": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Whether or not to attach a report on all open issues as an attachment to ticket, only relevant in CONTROL triggered actions",
+							},
+						},
+					},
+				},
+				"jira_create_ticket": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Create a Jira ticket. Requires a `wiz_integration_jira` integration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"summary": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     automationRuleDefaultTicketSummary,
+								Description: "Issue summary",
+							},
+							"description": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     automationRuleDefaultTicketDescription,
+								Description: "Issue description",
+							},
+							"issue_type": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "Vulnerability",
+								Description: "Issue type",
+							},
+							"assignee": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Issue assignee",
+							},
+							"components": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Issue components",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"fix_version": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Issue fix versions",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"labels": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Issue labels",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"priority": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Issue priority",
+							},
+							"project": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Issue project",
+							},
+							"alternative_description_field": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Issue alternative description field",
+							},
+							"custom_fields": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Custom configuration fields as specified in Jira. Must be valid JSON.",
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.StringIsJSON,
+								),
+							},
+							"attach_evidence_csv": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Upload issue evidence CSV as attachment?",
+							},
+						},
+					},
+				},
+				"jira_transition_ticket": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Transition a Jira ticket. Requires a `wiz_integration_jira` integration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"project": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Issue project",
+							},
+							"transition_id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Issue transition ID or Name",
+							},
+							"advanced_fields": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Advanced fields to set during the transition. Must be valid JSON.",
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.StringIsJSON,
+								),
+							},
+							"comment": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Issue Jira comment",
+							},
+							"comment_on_transition": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Whether or not to send comment during follow-up call, if this is disabled comment will be sent as update field",
+							},
+							"attach_evidence_csv": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Upload issues report as attachment Only relevant in CONTROL-triggered Actions.",
+							},
+						},
+					},
+				},
+				"opsgenie_close_alert": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Close an Opsgenie alert.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Opsgenie request body.",
+							},
+						},
+					},
+				},
+				"opsgenie_create_alert": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Create an Opsgenie alert.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Opsgenie request body.",
+							},
+						},
+					},
+				},
+				"pagerduty_create_incident": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Create a PagerDuty incident. Requires a `wiz_integration_pagerduty` integration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": {
+								Type:             schema.TypeString,
+								Required:         true,
+								Description:      "PagerDuty incident payload. Supports templated content, e.g. `{{issue.control.name}}`; the template syntax is validated during plan.",
+								ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+							},
+						},
+					},
+				},
+				"pagerduty_resolve_incident": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Resolve the PagerDuty incident of the issue, declared as an empty block. The action sends no action template params. Requires a `wiz_integration_pagerduty` integration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{},
+					},
+				},
+				"servicenow_create_ticket": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Create a ServiceNow ticket. Requires a `wiz_integration_servicenow` integration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"table_name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "incident",
+								Description: "Table name to which new tickets will be added to, e.g: 'incident'.",
+							},
+							"custom_fields": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Custom configuration fields as specified in Service Now. Must be valid JSON.",
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.StringIsJSON,
+								),
+							},
+							"summary": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "Wiz Issue: {{issue.control.name}}",
+								Description: "Ticket summary",
+							},
+							"description": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     automationRuleDefaultTicketDescription,
+								Description: "Ticket description",
+							},
+							"attach_evidence_csv": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Upload issue evidence CSV as attachment?",
+							},
+						},
+					},
+				},
+				"servicenow_update_ticket": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Update a ServiceNow ticket. Requires a `wiz_integration_servicenow` integration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"table_name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "incident",
+								Description: "Table name to which new tickets will be added to, e.g: 'incident'.",
+							},
+							"fields": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Fields to update. Must be valid JSON.",
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.StringIsJSON,
+								),
+							},
+							"attach_issues_report": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Upload issues report as attachment Only relevant in CONTROL-triggered Actions.",
+							},
+						},
+					},
+				},
+				"slack": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Send a Slack message using a webhook.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"note": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Note to add to the Slack message. Supports templated content, e.g. `{{issue.control.name}}`.",
+							},
+						},
+					},
+				},
+				"slack_bot": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Send a Slack message using a bot.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"channel": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Slack channel to send the message to, e.g. `#security-alerts`.",
+							},
+							"note": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Note to add to the Slack message. Supports templated content, e.g. `{{issue.control.name}}`.",
+							},
+						},
+					},
+				},
+				"webhook": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Send a webhook request.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:             schema.TypeString,
+								Required:         true,
+								Description:      "Webhook request body. Supports templated content, e.g. `{{issue.control.name}}`; the template syntax is validated during plan.",
+								ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+							},
+							"header": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Additional headers to send with the webhook request.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"key": {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "Header name.",
+										},
+										"value": {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "Header value.",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Automation Rules define associations between actions and findings. This resource supports any number of actions of any supported action template type.",
+		Schema:      ruleSchema,
+		CustomizeDiff: customdiff.All(
+			automationRuleFiltersCustomizeDiff,
+			validateAutomationRuleActions,
+		),
+		CreateContext: resourceWizAutomationRuleCreate,
+		ReadContext:   resourceWizAutomationRuleRead,
+		UpdateContext: resourceWizAutomationRuleUpdate,
+		DeleteContext: resourceWizAutomationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// automationRuleSchema returns the attributes shared by the automation rule resources
+func automationRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Wiz internal identifier.",
+			Computed:    true,
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date/time at which the automation rule was created.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the automation rule",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of the automation rule",
+		},
+		"trigger_source": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: fmt.Sprintf(
+				"Trigger source.\n    - Allowed values: %s",
+				utils.SliceOfStringToMDUList(
+					wiz.AutomationRuleTriggerSource,
+				),
+			),
+			ValidateDiagFunc: validation.ToDiagFunc(
+				validation.StringInSlice(
+					wiz.AutomationRuleTriggerSource,
+					false,
+				),
+			),
+		},
+		"trigger_type": {
+			Type:     schema.TypeList,
+			Required: true,
+			Description: fmt.Sprintf(
+				"Trigger type.\n    - Allowed values: %s",
+				utils.SliceOfStringToMDUList(
+					wiz.AutomationRuleTriggerType,
+				),
+			),
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.AutomationRuleTriggerType,
+						false,
+					),
+				),
+			},
+		},
+		"filters": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ExactlyOneOf: []string{
+				"filter",
+				"filters",
+			},
+			ValidateDiagFunc: validation.ToDiagFunc(
+				validation.StringIsJSON,
+			),
+			Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI. When `filter` is used, this is computed from the structured filter.",
+		},
+		"filter": automationRuleFilterSchema(),
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Enabled?",
+			Default:     true,
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Wiz internal ID for a project.",
+		},
+	}
+}
+
+// validateAutomationRuleActions ensures each action defines exactly one action template block or template_params_json
+func validateAutomationRuleActions(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for i, a := range d.Get("action").([]interface{}) {
+		if a == nil {
+			continue
+		}
+		actionConfig := a.(map[string]interface{})
+		templates := make([]string, 0)
+		for templateName := range automationRuleActionTemplateTypes {
+			if len(actionConfig[templateName].([]interface{})) > 0 {
+				templates = append(templates, templateName)
+			}
+		}
+		templateType := actionConfig["type"].(string)
+		if actionConfig["template_params_json"].(string) != "" {
+			templates = append(templates, "template_params_json")
+			if templateType == "" {
+				return fmt.Errorf("action.%d must set type when template_params_json is set", i)
+			}
+			for templateName, blockType := range automationRuleActionTemplateTypes {
+				if blockType == templateType {
+					return fmt.Errorf("action.%d must use the %s block for action template type %s", i, templateName, templateType)
+				}
+			}
+		}
+		if len(templates) != 1 {
+			return fmt.Errorf("action.%d must define exactly one action template block or template_params_json, got %d: %v", i, len(templates), templates)
+		}
+		if templateType != "" && templates[0] != "template_params_json" {
+			return fmt.Errorf("action.%d must not set type with the %s block, the action template type is derived from the block", i, templates[0])
+		}
+	}
+	return nil
+}
+
+func resourceWizAutomationRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizAutomationRuleCreate called...")
+
+	diags = createAutomationRule(ctx, d, m, getAutomationRuleActions(ctx, d), "automation_rule")
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizAutomationRuleRead(ctx, d, m)
+}
+
+// createAutomationRule creates the automation rule with the shared attributes and the actions, and sets the id
+func createAutomationRule(ctx context.Context, d *schema.ResourceData, m interface{}, actions []wiz.AutomationRuleActionInput, resourceType string) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "createAutomationRule called...")
+
+	// define the graphql query
+	query := `mutation CreateAutomationRule (
+	  $input: CreateAutomationRuleInput!
+	) {
+	  createAutomationRule(
+	    input: $input
+	  ) {
+	    automationRule {
+	      id
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.CreateAutomationRuleInput{}
+	vars.Name = d.Get("name").(string)
+	vars.Description = d.Get("description").(string)
+	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+	vars.Filters = getAutomationRuleFilters(ctx, d)
+	vars.ProjectID = d.Get("project_id").(string)
+	vars.TriggerType = utils.ConvertListToString(d.Get("trigger_type").([]interface{}))
+	vars.TriggerSource = d.Get("trigger_source").(string)
+	vars.Actions = actions
+
+	// process the request
+	data := &CreateAutomationRule{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, resourceType, "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateAutomationRule.AutomationRule.ID)
+
+	return diags
+}
+
+func resourceWizAutomationRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizAutomationRuleRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	rule, diags := readAutomationRule(ctx, d, m, "automation_rule")
+	if rule == nil {
+		return diags
+	}
+
+	actions, actionDiags := flattenAutomationRuleActions(ctx, rule.Actions, d.Get("action").([]interface{}))
+	diags = append(diags, actionDiags...)
+	err := d.Set("action", actions)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// readAutomationRule reads the automation rule and sets the shared attributes, it returns nil when the automation rule was not read
+func readAutomationRule(ctx context.Context, d *schema.ResourceData, m interface{}, resourceType string) (*wiz.AutomationRule, diag.Diagnostics) {
+	tflog.Info(ctx, "readAutomationRule called...")
+
+	var diags diag.Diagnostics
+
+	// define the graphql query
+	query := `query automationRule (
+	  $id: ID!
+	){
+	  automationRule(
+	    id: $id
+	  ){
+	    id
+	    name
+	    description
+	    createdAt
+	    triggerSource
+	    triggerType
+	    filters
+	    enabled
+	    project {
+	      id
+	    }
+	    actions {
+	      id
+	      actionTemplateType
+	      integration {
+	        id
+	      }
+	      actionTemplateParams {
+	        ... on AwsSnsActionTemplateParams {
+	          body
+	        }
+	        ... on AzureServiceBusActionTemplateParams {
+	          body
+	        }
+	        ... on ClickUpCreateTaskActionTemplateParams {
+	          listId
+	          body
+	        }
+	        ... on EmailActionTemplateParams {
+	          to
+	          cc
+	          note
+	          attachEvidenceCSV
+	        }
+	        ... on GcpPubSubActionTemplateParams {
+	          body
+	        }
+	        ... on GoogleChatActionTemplateParams {
+	          note
+	        }
+	        ... on JiraActionAddCommentTemplateParams {
+	          projectKey
+	          comment
+	          addIssuesReport
+	        }
+	        ... on JiraActionCreateTicketTemplateParams {
+	          fields {
+	            summary
+	            description
+	            issueType
+	            assignee
+	            components
+	            fixVersion
+	            labels
+	            priority
+	            project
+	            alternativeDescriptionField
+	            customFields
+	            attachEvidenceCSV
+	          }
+	        }
+	        ... on JiraActionTransitionTicketTemplateParams {
+	          project
+	          transitionId
+	          advancedFields
+	          transitionComment: comment
+	          commentOnTransition
+	          attachEvidenceCSV
+	        }
+	        ... on OpsgenieCloseAlertTemplateParams {
+	          body
+	        }
+	        ... on OpsgenieCreateAlertTemplateParams {
+	          body
+	        }
+	        ... on PagerDutyActionCreateIncidentTemplateParams {
+	          payload
+	        }
+	        ... on ServiceNowActionCreateTicketTemplateParams {
+	          fields {
+	            tableName
+	            customFields
+	            summary
+	            description
+	            attachEvidenceCSV
+	          }
+	        }
+	        ... on ServiceNowActionUpdateTicketTemplateParams {
+	          tableName
+	          serviceNowUpdateFields: fields
+	          attachIssuesReport
+	        }
+	        ... on SlackActionTemplateParams {
+	          note
+	        }
+	        ... on SlackBotActionTemplateParams {
+	          slackBotChannel: channel
+	          note
+	        }
+	        ... on WebhookActionTemplateParams {
+	          body
+	          headers {
+	            key
+	            value
+	          }
+	        }
+	      }
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadAutomationRulePayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, resourceType, "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.AutomationRule.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil, nil
+		}
+		return nil, diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.AutomationRule.Name)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("description", data.AutomationRule.Description)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("enabled", data.AutomationRule.Enabled)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("trigger_type", data.AutomationRule.TriggerType)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("trigger_source", data.AutomationRule.TriggerSource)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("filters", string(data.AutomationRule.Filters))
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	if len(d.Get("filter").([]interface{})) > 0 {
+		filter, err := flattenAutomationRuleFilter(ctx, data.AutomationRule.TriggerSource, data.AutomationRule.Filters)
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("filter", filter)
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+	}
+	err = d.Set("project_id", data.AutomationRule.Project.ID)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("created_at", data.AutomationRule.CreatedAt)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return &data.AutomationRule, diags
+}
+
+func resourceWizAutomationRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizAutomationRuleUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateAutomationRule(ctx, d, m, getAutomationRuleActions(ctx, d), "automation_rule")
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizAutomationRuleRead(ctx, d, m)
+}
+
+// updateAutomationRule updates the shared attributes and the actions of the automation rule
+func updateAutomationRule(ctx context.Context, d *schema.ResourceData, m interface{}, actions []wiz.AutomationRuleActionInput, resourceType string) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "updateAutomationRule called...")
+
+	// define the graphql query
+	query := `mutation updateAutomationRule($input: UpdateAutomationRuleInput!) {
+	  updateAutomationRule(
+	    input: $input
+	  ) {
+	    automationRule {
+	      id
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateAutomationRuleInput{}
+	vars.ID = d.Id()
+	vars.Patch.Name = d.Get("name").(string)
+	vars.Patch.Description = d.Get("description").(string)
+	vars.Patch.TriggerSource = d.Get("trigger_source").(string)
+	vars.Patch.TriggerType = utils.ConvertListToString(d.Get("trigger_type").([]interface{}))
+	vars.Patch.Filters = getAutomationRuleFilters(ctx, d)
+	vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+	vars.Patch.Actions = actions
+
+	// process the request
+	data := &UpdateAutomationRule{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, resourceType, "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
+
+// getAutomationRuleActions returns the actions for wiz_automation_rule
+func getAutomationRuleActions(ctx context.Context, d *schema.ResourceData) []wiz.AutomationRuleActionInput {
+	tflog.Info(ctx, "getAutomationRuleActions called...")
+
+	priorActions, actions := d.GetChange("action")
+	return getAutomationRuleActionInputs(ctx, actions.([]interface{}), priorActions.([]interface{}))
+}
+
+// getAutomationRuleActionInputs converts the action blocks to action inputs, the id of a prior action is retained only when the action at the same index keeps its action template type
+func getAutomationRuleActionInputs(ctx context.Context, actionConfigs []interface{}, priorActions []interface{}) []wiz.AutomationRuleActionInput {
+	actions := []wiz.AutomationRuleActionInput{}
+	for i, a := range actionConfigs {
+		if a == nil {
+			continue
+		}
+		actionConfig := a.(map[string]interface{})
+		action := wiz.AutomationRuleActionInput{
+			IntegrationID:      actionConfig["integration_id"].(string),
+			ActionTemplateType: getAutomationRuleActionTemplateType(actionConfig),
+		}
+		for templateName := range automationRuleActionTemplateTypes {
+			templates, _ := actionConfig[templateName].([]interface{})
+			if len(templates) == 0 || templates[0] == nil || automationRuleActionTemplatesWithoutParams[templateName] {
+				continue
+			}
+			action.ActionTemplateParams = getAutomationRuleActionTemplateParams(ctx, templateName, templates[0].(map[string]interface{}))
+		}
+		// action template types without a block are sent with their raw parameters
+		if params, _ := actionConfig["template_params_json"].(string); params != "" {
+			action.ActionTemplateParams = json.RawMessage(params)
+		}
+		if i < len(priorActions) && priorActions[i] != nil {
+			prior := priorActions[i].(map[string]interface{})
+			if getAutomationRuleActionTemplateType(prior) == action.ActionTemplateType {
+				action.ID, _ = prior["id"].(string)
+			}
+		}
+		tflog.Debug(ctx, fmt.Sprintf("action: %s", utils.PrettyPrint(action)))
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+// getAutomationRuleActionTemplateType derives the action template type from the action template block, or from type for template_params_json
+func getAutomationRuleActionTemplateType(actionConfig map[string]interface{}) string {
+	// a block without attributes, such as pagerduty_resolve_incident, is read as a nil element
+	for templateName, templateType := range automationRuleActionTemplateTypes {
+		if templates, ok := actionConfig[templateName].([]interface{}); ok && len(templates) > 0 {
+			return templateType
+		}
+	}
+	templateType, _ := actionConfig["type"].(string)
+	return templateType
+}
+
+func getAutomationRuleActionTemplateParams(ctx context.Context, templateName string, params map[string]interface{}) wiz.ActionTemplateParamsInput {
+	tflog.Trace(ctx, fmt.Sprintf("template: %s params: %s", templateName, utils.PrettyPrint(params)))
+
+	var output wiz.ActionTemplateParamsInput
+	switch templateName {
+	case "aws_sns":
+		output.AwsSNS = &wiz.AwsSNSActionTemplateParamsInput{
+			Body: params["body"].(string),
+		}
+	case "azure_service_bus":
+		output.AzureServiceBus = &wiz.AzureServiceBusActionTemplateParamsInput{
+			Body: params["body"].(string),
+		}
+	case "clickup_create_task":
+		output.ClickUpCreateTask = &wiz.ClickUpCreateTaskActionTemplateParamsInput{
+			ListID: params["list_id"].(string),
+			Body:   params["body"].(string),
+		}
+	case "email":
+		output.Email = &wiz.EmailActionTemplateParamsInput{
+			To:                utils.ConvertListToString(params["to"].([]interface{})),
+			CC:                utils.ConvertListToString(params["cc"].([]interface{})),
+			Note:              params["note"].(string),
+			AttachEvidenceCSV: utils.ConvertBoolToPointer(params["attach_evidence_csv"].(bool)),
+		}
+	case "gcp_pub_sub":
+		output.GcpPubSub = &wiz.GcpPubSubActionTemplateParamsInput{
+			Body: params["body"].(string),
+		}
+	case "google_chat":
+		output.GoogleChat = &wiz.GoogleChatActionTemplateParamsInput{
+			Note: params["note"].(string),
+		}
+	case "jira_add_comment":
+		output.JiraAddComment = &wiz.JiraActionAddCommentTemplateParamsInput{
+			ProjectKey:      params["project_key"].(string),
+			Comment:         params["comment"].(string),
+			AddIssuesReport: params["add_issues_report"].(bool),
+		}
+	case "jira_create_ticket":
+		output.JiraCreateTicket = &wiz.JiraActionCreateTicketTemplateParamsInput{
+			Fields: wiz.CreateJiraTicketFieldsInput{
+				Summary:                     params["summary"].(string),
+				Description:                 params["description"].(string),
+				IssueType:                   params["issue_type"].(string),
+				Assignee:                    params["assignee"].(string),
+				Components:                  utils.ConvertListToString(params["components"].([]interface{})),
+				FixVersion:                  utils.ConvertListToString(params["fix_version"].([]interface{})),
+				Labels:                      utils.ConvertListToString(params["labels"].([]interface{})),
+				Priority:                    params["priority"].(string),
+				Project:                     params["project"].(string),
+				AlternativeDescriptionField: params["alternative_description_field"].(string),
+				CustomFields:                getAutomationRuleActionJSON(params["custom_fields"].(string)),
+				AttachEvidenceCSV:           utils.ConvertBoolToPointer(params["attach_evidence_csv"].(bool)),
+			},
+		}
+	case "jira_transition_ticket":
+		output.JiraTransitionTicket = &wiz.JiraActionTransitionTicketTemplateParamsInput{
+			Project:             params["project"].(string),
+			TransitionID:        params["transition_id"].(string),
+			AdvancedFields:      getAutomationRuleActionJSON(params["advanced_fields"].(string)),
+			Comment:             params["comment"].(string),
+			CommentOnTransition: utils.ConvertBoolToPointer(params["comment_on_transition"].(bool)),
+			AttachEvidenceCSV:   utils.ConvertBoolToPointer(params["attach_evidence_csv"].(bool)),
+		}
+	case "opsgenie_close_alert":
+		output.OpsgenieCloseAlert = &wiz.OpsgenieCloseAlertTemplateParamsInput{
+			Body: params["body"].(string),
+		}
+	case "opsgenie_create_alert":
+		output.OpsgenieCreateAlert = &wiz.OpsgenieCreateAlertTemplateParamsInput{
+			Body: params["body"].(string),
+		}
+	case "pagerduty_create_incident":
+		output.PagerDutyCreateIncident = &wiz.PagerDutyActionCreateIncidentTemplateParamsInput{
+			Payload: params["payload"].(string),
+		}
+	case "servicenow_create_ticket":
+		output.ServiceNowCreateTicket = &wiz.ServiceNowActionCreateTicketTemplateParamsInput{
+			Fields: wiz.CreateServiceNowFieldsInput{
+				TableName:         params["table_name"].(string),
+				CustomFields:      getAutomationRuleActionJSON(params["custom_fields"].(string)),
+				Summary:           params["summary"].(string),
+				Description:       params["description"].(string),
+				AttachEvidenceCSV: params["attach_evidence_csv"].(bool),
+			},
+		}
+	case "servicenow_update_ticket":
+		output.ServiceNowUpdateTicket = &wiz.ServiceNowActionUpdateTicketTemplateParamsInput{
+			TableName:          params["table_name"].(string),
+			Fields:             getAutomationRuleActionJSON(params["fields"].(string)),
+			AttachIssuesReport: params["attach_issues_report"].(bool),
+		}
+	case "slack":
+		output.Slack = &wiz.SlackActionTemplateParamsInput{
+			Note: params["note"].(string),
+		}
+	case "slack_bot":
+		output.SlackBot = &wiz.SlackBotActionTemplateParamsInput{
+			Channel: params["channel"].(string),
+			Note:    params["note"].(string),
+		}
+	case "webhook":
+		output.Webhook = &wiz.WebhookActionTemplateParamsInput{
+			Body:    params["body"].(string),
+			Headers: getIntegrationWebhookHeaders(ctx, params["header"].([]interface{})),
+		}
+	}
+
+	return output
+}
+
+// getAutomationRuleActionJSON returns nil for empty JSON attributes so they are omitted from the request
+func getAutomationRuleActionJSON(value string) json.RawMessage {
+	if value == "" {
+		return nil
+	}
+	return json.RawMessage(value)
+}
+
+// flattenAutomationRuleActions converts the actions returned by the API to action blocks
+// template_params_json is carried over from the prior action blocks as the API does not return it
+func flattenAutomationRuleActions(ctx context.Context, actions []*wiz.AutomationRuleAction, priorActions []interface{}) ([]interface{}, diag.Diagnostics) {
+	tflog.Info(ctx, "flattenAutomationRuleActions called...")
+
+	var diags diag.Diagnostics
+	var priorParams = make(map[string]string)
+	for _, a := range priorActions {
+		if a == nil {
+			continue
+		}
+		prior := a.(map[string]interface{})
+		if id, ok := prior["id"].(string); ok && id != "" {
+			priorParams[id], _ = prior["template_params_json"].(string)
+		}
+	}
+
+	var output = make([]interface{}, 0, 0)
+	for _, a := range actions {
+		tflog.Trace(ctx, fmt.Sprintf("action: %s", utils.PrettyPrint(a)))
+
+		var action = make(map[string]interface{})
+		action["id"] = a.ID
+		action["integration_id"] = a.Integration.ID
+
+		// restore aliased parameters and convert the generic params to the specific type
+		params := make(map[string]interface{})
+		if p, ok := a.ActionTemplateParams.(map[string]interface{}); ok {
+			params = p
+		}
+		for alias, field := range automationRuleActionParamAliases {
+			if value, ok := params[alias]; ok {
+				params[field] = value
+				delete(params, alias)
+			}
+		}
+		jsonString, err := json.Marshal(params)
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+
+		var templateName string
+		for name, templateType := range automationRuleActionTemplateTypes {
+			if templateType == a.ActionTemplateType {
+				templateName = name
+			}
+		}
+
+		var template = make(map[string]interface{})
+		switch templateName {
+		case "aws_sns":
+			p := &wiz.AwsSnsActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["body"] = p.Body
+		case "azure_service_bus":
+			p := &wiz.AzureServiceBusActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["body"] = p.Body
+		case "clickup_create_task":
+			p := &wiz.ClickUpCreateTaskActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["list_id"] = p.ListID
+			template["body"] = p.Body
+		case "email":
+			p := &wiz.EmailActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["to"] = utils.ConvertSliceToGenericArray(p.To)
+			template["cc"] = utils.ConvertSliceToGenericArray(p.CC)
+			template["note"] = p.Note
+			template["attach_evidence_csv"] = p.AttachEvidenceCSV != nil && *p.AttachEvidenceCSV
+		case "gcp_pub_sub":
+			p := &wiz.GcpPubSubActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["body"] = p.Body
+		case "google_chat":
+			p := &wiz.GoogleChatActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["note"] = p.Note
+		case "jira_add_comment":
+			p := &wiz.JiraActionAddCommentTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["project_key"] = p.ProjectKey
+			template["comment"] = p.Comment
+			template["add_issues_report"] = p.AddIssuesReport
+		case "jira_create_ticket":
+			p := &wiz.JiraActionCreateTicketTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["summary"] = p.Fields.Summary
+			template["description"] = p.Fields.Description
+			template["issue_type"] = p.Fields.IssueType
+			template["assignee"] = p.Fields.Assignee
+			template["components"] = utils.ConvertSliceToGenericArray(p.Fields.Components)
+			template["fix_version"] = utils.ConvertSliceToGenericArray(p.Fields.FixVersion)
+			template["labels"] = utils.ConvertSliceToGenericArray(p.Fields.Labels)
+			template["priority"] = p.Fields.Priority
+			template["project"] = p.Fields.Project
+			template["alternative_description_field"] = p.Fields.AlternativeDescriptionField
+			template["custom_fields"] = flattenAutomationRuleActionJSON(p.Fields.CustomFields)
+			template["attach_evidence_csv"] = p.Fields.AttachEvidenceCSV != nil && *p.Fields.AttachEvidenceCSV
+		case "jira_transition_ticket":
+			p := &wiz.JiraActionTransitionTicketTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["project"] = p.Project
+			template["transition_id"] = p.TransitionID
+			template["advanced_fields"] = flattenAutomationRuleActionJSON(p.AdvancedFields)
+			template["comment"] = p.Comment
+			template["comment_on_transition"] = p.CommentOnTransition != nil && *p.CommentOnTransition
+			template["attach_evidence_csv"] = p.AttachEvidenceCSV != nil && *p.AttachEvidenceCSV
+		case "opsgenie_close_alert":
+			p := &wiz.OpsgenieCloseAlertTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["body"] = p.Body
+		case "opsgenie_create_alert":
+			p := &wiz.OpsgenieCreateAlertTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["body"] = p.Body
+		case "pagerduty_create_incident":
+			p := &wiz.PagerDutyActionCreateIncidentTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["payload"] = p.Payload
+		case "pagerduty_resolve_incident":
+			// the action has no action template params
+		case "servicenow_create_ticket":
+			p := &wiz.ServiceNowActionCreateTicketTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["table_name"] = p.Fields.TableName
+			template["custom_fields"] = flattenAutomationRuleActionJSON(p.Fields.CustomFields)
+			template["summary"] = p.Fields.Summary
+			template["description"] = p.Fields.Description
+			template["attach_evidence_csv"] = p.Fields.AttachEvidenceCSV != nil && *p.Fields.AttachEvidenceCSV
+		case "servicenow_update_ticket":
+			p := &wiz.ServiceNowActionUpdateTicketTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["table_name"] = p.TableName
+			template["fields"] = flattenAutomationRuleActionJSON(p.Fields)
+			template["attach_issues_report"] = p.AttachIssuesReport != nil && *p.AttachIssuesReport
+		case "slack":
+			p := &wiz.SlackActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["note"] = p.Note
+		case "slack_bot":
+			p := &wiz.SlackBotActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["channel"] = p.Channel
+			template["note"] = p.Note
+		case "webhook":
+			p := &wiz.WebhookActionTemplateParams{}
+			err = json.Unmarshal(jsonString, p)
+			template["body"] = p.Body
+			template["header"] = flattenIntegrationWebhookHeaders(ctx, p.Headers)
+		default:
+			action["type"] = a.ActionTemplateType
+			action["template_params_json"] = priorParams[a.ID]
+			if priorParams[a.ID] == "" {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Unsupported action template type",
+					Detail:   fmt.Sprintf("Action %s uses action template type %s, which has no action template block in wiz_automation_rule. Set type and template_params_json to manage its parameters.", a.ID, a.ActionTemplateType),
+				})
+			}
+		}
+		if err != nil {
+			return nil, append(diags, diag.FromErr(fmt.Errorf("failed to read the parameters of action %s: %w", a.ID, err))...)
+		}
+		if templateName != "" {
+			action[templateName] = []interface{}{template}
+		}
+
+		output = append(output, action)
+	}
+
+	return output, diags
+}
+
+// flattenAutomationRuleActionJSON returns an empty string for null JSON values
+func flattenAutomationRuleActionJSON(value json.RawMessage) string {
+	if len(value) == 0 || string(value) == "null" {
+		return ""
+	}
+	return string(value)
+}
+
 func resourceWizAutomationRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	tflog.Info(ctx, "resourceWizAutomationRuleDelete called...")
 
@@ -64,3 +1442,1143 @@ func resourceWizAutomationRuleDelete(ctx context.Context, d *schema.ResourceData
 
 	return diags
 }
+
+// automationRuleFilterSchema returns the structured filter block shared by the automation rule resources
+func automationRuleFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Structured filter for the automation rule. This is serialized to the Wiz filter format of the trigger source and is an alternative to `filters`. The issue criteria apply to the `ISSUES` trigger source, and to the issues of the matched controls with the `CONTROL` trigger source. The `configuration_finding` and `cloud_event` blocks hold the criteria of the `CONFIGURATION_FINDING` and `CLOUD_EVENTS` trigger sources, which do not accept issue criteria.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"control": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Criteria for the controls, requires the `CONTROL` trigger source.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ids": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the controls to match.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"search": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Free text search on the control name.",
+							},
+							"type": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Description: fmt.Sprintf(
+									"Control types to match.\n    - Allowed values: %s",
+									utils.SliceOfStringToMDUList(
+										wiz.ControlType,
+									),
+								),
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateDiagFunc: validation.ToDiagFunc(
+										validation.StringInSlice(
+											wiz.ControlType,
+											false,
+										),
+									),
+								},
+							},
+							"created_by": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Description: fmt.Sprintf(
+									"Control creator to match.\n    - Allowed values: %s",
+									utils.SliceOfStringToMDUList(
+										wiz.ControlCreatorType,
+									),
+								),
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.StringInSlice(
+										wiz.ControlCreatorType,
+										false,
+									),
+								),
+							},
+							"severity": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Description: fmt.Sprintf(
+									"Control severity to match.\n    - Allowed values: %s",
+									utils.SliceOfStringToMDUList(
+										wiz.Severity,
+									),
+								),
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.StringInSlice(
+										wiz.Severity,
+										false,
+									),
+								),
+							},
+							"project": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the projects of the controls.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"security_framework": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the security frameworks of the controls.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"security_category": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the security categories of the controls.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"security_sub_category": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the security sub-categories of the controls.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"framework_category": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the framework categories of the controls.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"risk_equals_any": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Match controls with any of the listed risks.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"risk_equals_all": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Match controls with all of the listed risks.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				"configuration_finding": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Criteria for the configuration findings, requires the `CONFIGURATION_FINDING` trigger source.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"search": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Free text search.",
+							},
+							"severity": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Description: fmt.Sprintf(
+									"Finding severities to match.\n    - Allowed values: %s",
+									utils.SliceOfStringToMDUList(
+										wiz.Severity,
+									),
+								),
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateDiagFunc: validation.ToDiagFunc(
+										validation.StringInSlice(
+											wiz.Severity,
+											false,
+										),
+									),
+								},
+							},
+							"project": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the projects of the findings.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"rule": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MaxItems:    1,
+								Description: "Criteria for the cloud configuration rules that generated the findings.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"ids": {
+											Type:        schema.TypeList,
+											Optional:    true,
+											Description: "Wiz internal IDs for the cloud configuration rules.",
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"search": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "Free text search on the rule name.",
+										},
+										"cloud_provider": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Description: fmt.Sprintf(
+												"Cloud providers of the rules.\n    - Allowed values: %s",
+												utils.SliceOfStringToMDUList(
+													wiz.CloudProvider,
+												),
+											),
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+												ValidateDiagFunc: validation.ToDiagFunc(
+													validation.StringInSlice(
+														wiz.CloudProvider,
+														false,
+													),
+												),
+											},
+										},
+										"service_type": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Description: fmt.Sprintf(
+												"Service types of the rules.\n    - Allowed values: %s",
+												utils.SliceOfStringToMDUList(
+													wiz.CloudConfigurationRuleServiceType,
+												),
+											),
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+												ValidateDiagFunc: validation.ToDiagFunc(
+													validation.StringInSlice(
+														wiz.CloudConfigurationRuleServiceType,
+														false,
+													),
+												),
+											},
+										},
+										"benchmark": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Description: fmt.Sprintf(
+												"Benchmarks of the rules.\n    - Allowed values: %s",
+												utils.SliceOfStringToMDUList(
+													wiz.ConfigurationBenchmarkTypeID,
+												),
+											),
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+												ValidateDiagFunc: validation.ToDiagFunc(
+													validation.StringInSlice(
+														wiz.ConfigurationBenchmarkTypeID,
+														false,
+													),
+												),
+											},
+										},
+										"security_framework": {
+											Type:        schema.TypeList,
+											Optional:    true,
+											Description: "Wiz internal IDs for the security frameworks of the rules.",
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"security_sub_category": {
+											Type:        schema.TypeList,
+											Optional:    true,
+											Description: "Wiz internal IDs for the security sub-categories of the rules.",
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"cloud_event": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Criteria for the cloud events, requires the `CLOUD_EVENTS` trigger source.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"cloud_platform": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Description: fmt.Sprintf(
+									"Cloud platforms of the events.\n    - Allowed values: %s",
+									utils.SliceOfStringToMDUList(
+										wiz.CloudPlatform,
+									),
+								),
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateDiagFunc: validation.ToDiagFunc(
+										validation.StringInSlice(
+											wiz.CloudPlatform,
+											false,
+										),
+									),
+								},
+							},
+							"severity": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Description: fmt.Sprintf(
+									"Event severities to match.\n    - Allowed values: %s",
+									utils.SliceOfStringToMDUList(
+										wiz.Severity,
+									),
+								),
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateDiagFunc: validation.ToDiagFunc(
+										validation.StringInSlice(
+											wiz.Severity,
+											false,
+										),
+									),
+								},
+							},
+							"project": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the projects of the events.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"subscription_id": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the subscriptions (cloud accounts) of the events.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				"search": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Free text search.",
+				},
+				"severity": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Description: fmt.Sprintf(
+						"Severities to match.\n    - Allowed values: %s",
+						utils.SliceOfStringToMDUList(
+							wiz.Severity,
+						),
+					),
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(
+							validation.StringInSlice(
+								wiz.Severity,
+								false,
+							),
+						),
+					},
+				},
+				"status": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Description: fmt.Sprintf(
+						"Issue statuses to match.\n    - Allowed values: %s",
+						utils.SliceOfStringToMDUList(
+							wiz.IssueStatus,
+						),
+					),
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(
+							validation.StringInSlice(
+								wiz.IssueStatus,
+								false,
+							),
+						),
+					},
+				},
+				"project": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Wiz internal IDs for projects to match.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"source_control": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Wiz internal IDs for the controls that generated the finding.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"source_control_type": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Description: fmt.Sprintf(
+						"Types of the controls that generated the finding.\n    - Allowed values: %s",
+						utils.SliceOfStringToMDUList(
+							wiz.ControlType,
+						),
+					),
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(
+							validation.StringInSlice(
+								wiz.ControlType,
+								false,
+							),
+						),
+					},
+				},
+				"security_category": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Wiz internal IDs for security categories to match.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"security_sub_category": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Wiz internal IDs for security sub-categories to match.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"framework_category": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Wiz internal IDs for framework categories to match.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"stack_layer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Description: fmt.Sprintf(
+						"Technology stack layers to match.\n    - Allowed values: %s",
+						utils.SliceOfStringToMDUList(
+							wiz.TechnologyStackLayer,
+						),
+					),
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(
+							validation.StringInSlice(
+								wiz.TechnologyStackLayer,
+								false,
+							),
+						),
+					},
+				},
+				"resolution_reason": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Description: fmt.Sprintf(
+						"Issue resolution reasons to match.\n    - Allowed values: %s",
+						utils.SliceOfStringToMDUList(
+							wiz.IssueResolutionReason,
+						),
+					),
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(
+							validation.StringInSlice(
+								wiz.IssueResolutionReason,
+								false,
+							),
+						),
+					},
+				},
+				"risk_equals_any": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Match findings with any of the listed risks.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"risk_equals_all": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Match findings with all of the listed risks.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"related_entity": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Criteria for the resource related to the finding.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ids": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the related entities.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"type": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Graph entity type, for example `VIRTUAL_MACHINE`. Must be a valid Wiz graph entity type.",
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.StringInSlice(
+										wiz.GraphEntityType,
+										false,
+									),
+								),
+							},
+							"native_type": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Cloud provider native types.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"cloud_platform": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Description: fmt.Sprintf(
+									"Cloud platforms.\n    - Allowed values: %s",
+									utils.SliceOfStringToMDUList(
+										wiz.CloudPlatform,
+									),
+								),
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateDiagFunc: validation.ToDiagFunc(
+										validation.StringInSlice(
+											wiz.CloudPlatform,
+											false,
+										),
+									),
+								},
+							},
+							"region": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Cloud regions.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"subscription_id": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the subscriptions (cloud accounts).",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"resource_group_id": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Wiz internal IDs for the resource groups.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"status": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Description: fmt.Sprintf(
+									"Cloud resource statuses.\n    - Allowed values: %s",
+									utils.SliceOfStringToMDUList(
+										wiz.CloudResourceStatus,
+									),
+								),
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateDiagFunc: validation.ToDiagFunc(
+										validation.StringInSlice(
+											wiz.CloudResourceStatus,
+											false,
+										),
+									),
+								},
+							},
+							"tag": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MaxItems:    1,
+								Description: "Resource tag criteria.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"contains_all":         automationRuleFilterTagSchema("Match resources with all of these tags."),
+										"contains_any":         automationRuleFilterTagSchema("Match resources with any of these tags."),
+										"does_not_contain_all": automationRuleFilterTagSchema("Exclude resources with all of these tags."),
+										"does_not_contain_any": automationRuleFilterTagSchema("Exclude resources with any of these tags."),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func automationRuleFilterTagSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Tag key.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Tag value. Omit to match any value.",
+				},
+			},
+		},
+	}
+}
+
+// automationRuleFilterTriggerSources maps the criteria blocks of the structured filter to the trigger source they require
+var automationRuleFilterTriggerSources = map[string]string{
+	"control":               "CONTROL",
+	"configuration_finding": "CONFIGURATION_FINDING",
+	"cloud_event":           "CLOUD_EVENTS",
+}
+
+// automationRuleFiltersCustomizeDiff validates the structured filter block against the trigger source and marks the filters JSON as unknown when it changes
+func automationRuleFiltersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	filter := d.Get("filter").([]interface{})
+	if len(filter) == 0 || filter[0] == nil {
+		return nil
+	}
+
+	triggerSource := d.Get("trigger_source").(string)
+	filterMap := filter[0].(map[string]interface{})
+	for block, source := range automationRuleFilterTriggerSources {
+		if len(filterMap[block].([]interface{})) > 0 && triggerSource != source {
+			return fmt.Errorf("filter.%s requires the %s trigger source", block, source)
+		}
+	}
+	switch triggerSource {
+	case "CONFIGURATION_FINDING", "CLOUD_EVENTS":
+		if issueFilters := getAutomationRuleIssueFilters(ctx, filterMap); !reflect.DeepEqual(*issueFilters, wiz.IssueFilters{}) {
+			return fmt.Errorf("the %s trigger source does not support issue criteria in the filter block", triggerSource)
+		}
+	}
+
+	if d.HasChange("filter") {
+		return d.SetNewComputed("filters")
+	}
+	return nil
+}
+
+// getAutomationRuleFilters returns the filters for the automation rule, using the filter block when set and the filters JSON otherwise
+func getAutomationRuleFilters(ctx context.Context, d *schema.ResourceData) json.RawMessage {
+	tflog.Info(ctx, "getAutomationRuleFilters called...")
+
+	filter := d.Get("filter").([]interface{})
+	if len(filter) == 0 || filter[0] == nil {
+		return json.RawMessage(d.Get("filters").(string))
+	}
+
+	var output interface{} = getAutomationRuleIssueFilters(ctx, filter[0].(map[string]interface{}))
+	switch d.Get("trigger_source").(string) {
+	case "CONFIGURATION_FINDING":
+		configurationFindingFilters := &wiz.ConfigurationFindingFilters{}
+		for _, c := range filter[0].(map[string]interface{})["configuration_finding"].([]interface{}) {
+			if c == nil {
+				continue
+			}
+			configurationFindingFilters = getAutomationRuleConfigurationFindingFilters(c.(map[string]interface{}))
+		}
+		output = configurationFindingFilters
+	case "CLOUD_EVENTS":
+		cloudEventFilters := &wiz.CloudEventFilters{}
+		for _, c := range filter[0].(map[string]interface{})["cloud_event"].([]interface{}) {
+			if c == nil {
+				continue
+			}
+			cloudEventFilters = getAutomationRuleCloudEventFilters(c.(map[string]interface{}))
+		}
+		output = cloudEventFilters
+	case "CONTROL":
+		controlFilters := &wiz.ControlFilters{}
+		for _, c := range filter[0].(map[string]interface{})["control"].([]interface{}) {
+			if c == nil {
+				continue
+			}
+			controlFilters = getAutomationRuleControlFilters(c.(map[string]interface{}))
+		}
+		// the issue criteria filter the issues of the matched controls
+		if issueFilters := output.(*wiz.IssueFilters); !reflect.DeepEqual(*issueFilters, wiz.IssueFilters{}) {
+			controlFilters.WithIssues = issueFilters
+		}
+		output = controlFilters
+	}
+	tflog.Debug(ctx, fmt.Sprintf("filter: %s", utils.PrettyPrint(output)))
+
+	filters, _ := json.Marshal(output)
+	return json.RawMessage(filters)
+}
+
+// getAutomationRuleIssueFilters returns the issue criteria of the structured filter
+func getAutomationRuleIssueFilters(ctx context.Context, filter map[string]interface{}) *wiz.IssueFilters {
+	output := &wiz.IssueFilters{}
+	for b, c := range filter {
+		tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, b))
+		tflog.Trace(ctx, fmt.Sprintf("c: %T %s", c, c))
+		switch b {
+		case "search":
+			output.Search = c.(string)
+		case "severity":
+			output.Severity = utils.ConvertListToString(c.([]interface{}))
+		case "status":
+			output.Status = utils.ConvertListToString(c.([]interface{}))
+		case "project":
+			output.Project = utils.ConvertListToString(c.([]interface{}))
+		case "source_control":
+			output.SourceControl = utils.ConvertListToString(c.([]interface{}))
+		case "source_control_type":
+			output.SourceControlType = utils.ConvertListToString(c.([]interface{}))
+		case "security_category":
+			output.SecurityCategory = utils.ConvertListToString(c.([]interface{}))
+		case "security_sub_category":
+			output.SecuritySubCategory = utils.ConvertListToString(c.([]interface{}))
+		case "framework_category":
+			output.FrameworkCategory = utils.ConvertListToString(c.([]interface{}))
+		case "stack_layer":
+			output.StackLayer = utils.ConvertListToString(c.([]interface{}))
+		case "resolution_reason":
+			output.ResolutionReason = utils.ConvertListToString(c.([]interface{}))
+		case "risk_equals_any":
+			output.RiskEqualsAny = utils.ConvertListToString(c.([]interface{}))
+		case "risk_equals_all":
+			output.RiskEqualsAll = utils.ConvertListToString(c.([]interface{}))
+		case "related_entity":
+			for _, f := range c.([]interface{}) {
+				if f == nil {
+					continue
+				}
+				output.RelatedEntity = getAutomationRuleFilterRelatedEntity(ctx, f.(map[string]interface{}))
+			}
+		}
+	}
+	return output
+}
+
+// getAutomationRuleControlFilters returns the control criteria of the structured filter
+func getAutomationRuleControlFilters(control map[string]interface{}) *wiz.ControlFilters {
+	return &wiz.ControlFilters{
+		ID:                  utils.ConvertListToString(control["ids"].([]interface{})),
+		Search:              control["search"].(string),
+		Type:                utils.ConvertListToString(control["type"].([]interface{})),
+		CreatedBy:           control["created_by"].(string),
+		Severity:            control["severity"].(string),
+		Project:             utils.ConvertListToString(control["project"].([]interface{})),
+		SecurityFramework:   utils.ConvertListToString(control["security_framework"].([]interface{})),
+		SecurityCategory:    utils.ConvertListToString(control["security_category"].([]interface{})),
+		SecuritySubCategory: utils.ConvertListToString(control["security_sub_category"].([]interface{})),
+		FrameworkCategory:   utils.ConvertListToString(control["framework_category"].([]interface{})),
+		RiskEqualsAny:       utils.ConvertListToString(control["risk_equals_any"].([]interface{})),
+		RiskEqualsAll:       utils.ConvertListToString(control["risk_equals_all"].([]interface{})),
+	}
+}
+
+// getAutomationRuleConfigurationFindingFilters returns the configuration finding criteria of the structured filter
+func getAutomationRuleConfigurationFindingFilters(configurationFinding map[string]interface{}) *wiz.ConfigurationFindingFilters {
+	output := &wiz.ConfigurationFindingFilters{
+		Search:   configurationFinding["search"].(string),
+		Severity: utils.ConvertListToString(configurationFinding["severity"].([]interface{})),
+		Project:  utils.ConvertListToString(configurationFinding["project"].([]interface{})),
+	}
+	for _, r := range configurationFinding["rule"].([]interface{}) {
+		if r == nil {
+			continue
+		}
+		rule := r.(map[string]interface{})
+		output.Rule = &wiz.CloudConfigurationRuleFilters{
+			ID:                  utils.ConvertListToString(rule["ids"].([]interface{})),
+			Search:              rule["search"].(string),
+			CloudProvider:       utils.ConvertListToString(rule["cloud_provider"].([]interface{})),
+			ServiceType:         utils.ConvertListToString(rule["service_type"].([]interface{})),
+			Benchmark:           utils.ConvertListToString(rule["benchmark"].([]interface{})),
+			SecurityFramework:   utils.ConvertListToString(rule["security_framework"].([]interface{})),
+			SecuritySubCategory: utils.ConvertListToString(rule["security_sub_category"].([]interface{})),
+		}
+	}
+	return output
+}
+
+// getAutomationRuleCloudEventFilters returns the cloud event criteria of the structured filter
+func getAutomationRuleCloudEventFilters(cloudEvent map[string]interface{}) *wiz.CloudEventFilters {
+	return &wiz.CloudEventFilters{
+		CloudPlatform:  utils.ConvertListToString(cloudEvent["cloud_platform"].([]interface{})),
+		Severity:       utils.ConvertListToString(cloudEvent["severity"].([]interface{})),
+		Project:        utils.ConvertListToString(cloudEvent["project"].([]interface{})),
+		SubscriptionID: utils.ConvertListToString(cloudEvent["subscription_id"].([]interface{})),
+	}
+}
+
+// getAutomationRuleFilterSeverities returns the severities of the structured filter, or of the filters JSON when the structured filter is not used
+func getAutomationRuleFilterSeverities(ctx context.Context, d *schema.ResourceData) []string {
+	tflog.Info(ctx, "getAutomationRuleFilterSeverities called...")
+
+	filter := d.Get("filter").([]interface{})
+	if len(filter) > 0 && filter[0] != nil {
+		return utils.ConvertListToString(filter[0].(map[string]interface{})["severity"].([]interface{}))
+	}
+
+	filters := &wiz.IssueFilters{}
+	err := json.Unmarshal([]byte(d.Get("filters").(string)), filters)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to parse filters: %s", err))
+		return nil
+	}
+
+	return filters.Severity
+}
+
+func getAutomationRuleFilterRelatedEntity(ctx context.Context, relatedEntity map[string]interface{}) *wiz.IssueEntityFilters {
+	output := &wiz.IssueEntityFilters{}
+	for g, h := range relatedEntity {
+		tflog.Trace(ctx, fmt.Sprintf("g: %T %s", g, g))
+		tflog.Trace(ctx, fmt.Sprintf("h: %T %s", h, h))
+		switch g {
+		case "ids":
+			output.IDs = utils.ConvertListToString(h.([]interface{}))
+		case "type":
+			output.Type = h.(string)
+		case "native_type":
+			output.NativeType = utils.ConvertListToString(h.([]interface{}))
+		case "cloud_platform":
+			output.CloudPlatform = utils.ConvertListToString(h.([]interface{}))
+		case "region":
+			output.Region = utils.ConvertListToString(h.([]interface{}))
+		case "subscription_id":
+			output.SubscriptionID = utils.ConvertListToString(h.([]interface{}))
+		case "resource_group_id":
+			output.ResourceGroupID = utils.ConvertListToString(h.([]interface{}))
+		case "status":
+			output.Status = utils.ConvertListToString(h.([]interface{}))
+		case "tag":
+			for _, t := range h.([]interface{}) {
+				if t == nil {
+					continue
+				}
+				tags := t.(map[string]interface{})
+				output.Tag = &wiz.IssueEntityTagFilter{
+					ContainsAll:       getAutomationRuleFilterTags(tags["contains_all"].([]interface{})),
+					ContainsAny:       getAutomationRuleFilterTags(tags["contains_any"].([]interface{})),
+					DoesNotContainAll: getAutomationRuleFilterTags(tags["does_not_contain_all"].([]interface{})),
+					DoesNotContainAny: getAutomationRuleFilterTags(tags["does_not_contain_any"].([]interface{})),
+				}
+			}
+		}
+	}
+	return output
+}
+
+func getAutomationRuleFilterTags(tags []interface{}) []wiz.IssueEntityTag {
+	var output []wiz.IssueEntityTag
+	for _, a := range tags {
+		tag := a.(map[string]interface{})
+		output = append(output, wiz.IssueEntityTag{
+			Key:   tag["key"].(string),
+			Value: tag["value"].(string),
+		})
+	}
+	return output
+}
+
+// flattenAutomationRuleFilter converts the filters returned by the API to the structured filter block, the filters format depends on the trigger source
+func flattenAutomationRuleFilter(ctx context.Context, triggerSource string, filters json.RawMessage) ([]interface{}, error) {
+	tflog.Info(ctx, "flattenAutomationRuleFilter called...")
+
+	var output = make([]interface{}, 0, 0)
+	if len(filters) == 0 || string(filters) == "null" {
+		return output, nil
+	}
+
+	switch triggerSource {
+	case "CONFIGURATION_FINDING":
+		return flattenAutomationRuleConfigurationFindingFilter(filters)
+	case "CLOUD_EVENTS":
+		return flattenAutomationRuleCloudEventFilter(filters)
+	}
+
+	issueFilters := &wiz.IssueFilters{}
+	var controls = make([]interface{}, 0, 0)
+	if triggerSource == "CONTROL" {
+		controlFilters := &wiz.ControlFilters{}
+		err := json.Unmarshal(filters, controlFilters)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Debug(ctx, fmt.Sprintf("controlFilters: %s", utils.PrettyPrint(controlFilters)))
+
+		if controlFilters.WithIssues != nil {
+			issueFilters = controlFilters.WithIssues
+			controlFilters.WithIssues = nil
+		}
+		if !reflect.DeepEqual(*controlFilters, wiz.ControlFilters{}) {
+			controls = append(controls, map[string]interface{}{
+				"ids":                   utils.ConvertSliceToGenericArray(controlFilters.ID),
+				"search":                controlFilters.Search,
+				"type":                  utils.ConvertSliceToGenericArray(controlFilters.Type),
+				"created_by":            controlFilters.CreatedBy,
+				"severity":              controlFilters.Severity,
+				"project":               utils.ConvertSliceToGenericArray(controlFilters.Project),
+				"security_framework":    utils.ConvertSliceToGenericArray(controlFilters.SecurityFramework),
+				"security_category":     utils.ConvertSliceToGenericArray(controlFilters.SecurityCategory),
+				"security_sub_category": utils.ConvertSliceToGenericArray(controlFilters.SecuritySubCategory),
+				"framework_category":    utils.ConvertSliceToGenericArray(controlFilters.FrameworkCategory),
+				"risk_equals_any":       utils.ConvertSliceToGenericArray(controlFilters.RiskEqualsAny),
+				"risk_equals_all":       utils.ConvertSliceToGenericArray(controlFilters.RiskEqualsAll),
+			})
+		}
+	} else {
+		err := json.Unmarshal(filters, issueFilters)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("issueFilters: %s", utils.PrettyPrint(issueFilters)))
+
+	var filter = make(map[string]interface{})
+	filter["search"] = issueFilters.Search
+	filter["severity"] = utils.ConvertSliceToGenericArray(issueFilters.Severity)
+	filter["status"] = utils.ConvertSliceToGenericArray(issueFilters.Status)
+	filter["project"] = utils.ConvertSliceToGenericArray(issueFilters.Project)
+	filter["source_control"] = utils.ConvertSliceToGenericArray(issueFilters.SourceControl)
+	filter["source_control_type"] = utils.ConvertSliceToGenericArray(issueFilters.SourceControlType)
+	filter["security_category"] = utils.ConvertSliceToGenericArray(issueFilters.SecurityCategory)
+	filter["security_sub_category"] = utils.ConvertSliceToGenericArray(issueFilters.SecuritySubCategory)
+	filter["framework_category"] = utils.ConvertSliceToGenericArray(issueFilters.FrameworkCategory)
+	filter["stack_layer"] = utils.ConvertSliceToGenericArray(issueFilters.StackLayer)
+	filter["resolution_reason"] = utils.ConvertSliceToGenericArray(issueFilters.ResolutionReason)
+	filter["risk_equals_any"] = utils.ConvertSliceToGenericArray(issueFilters.RiskEqualsAny)
+	filter["risk_equals_all"] = utils.ConvertSliceToGenericArray(issueFilters.RiskEqualsAll)
+
+	var relatedEntities = make([]interface{}, 0, 0)
+	if issueFilters.RelatedEntity != nil && !reflect.DeepEqual(*issueFilters.RelatedEntity, wiz.IssueEntityFilters{}) {
+		relatedEntity := issueFilters.RelatedEntity
+		var entity = make(map[string]interface{})
+		entity["ids"] = utils.ConvertSliceToGenericArray(relatedEntity.IDs)
+		entity["type"] = relatedEntity.Type
+		entity["native_type"] = utils.ConvertSliceToGenericArray(relatedEntity.NativeType)
+		entity["cloud_platform"] = utils.ConvertSliceToGenericArray(relatedEntity.CloudPlatform)
+		entity["region"] = utils.ConvertSliceToGenericArray(relatedEntity.Region)
+		entity["subscription_id"] = utils.ConvertSliceToGenericArray(relatedEntity.SubscriptionID)
+		entity["resource_group_id"] = utils.ConvertSliceToGenericArray(relatedEntity.ResourceGroupID)
+		entity["status"] = utils.ConvertSliceToGenericArray(relatedEntity.Status)
+
+		var tags = make([]interface{}, 0, 0)
+		if relatedEntity.Tag != nil && !reflect.DeepEqual(*relatedEntity.Tag, wiz.IssueEntityTagFilter{}) {
+			tags = append(tags, map[string]interface{}{
+				"contains_all":         flattenAutomationRuleFilterTags(relatedEntity.Tag.ContainsAll),
+				"contains_any":         flattenAutomationRuleFilterTags(relatedEntity.Tag.ContainsAny),
+				"does_not_contain_all": flattenAutomationRuleFilterTags(relatedEntity.Tag.DoesNotContainAll),
+				"does_not_contain_any": flattenAutomationRuleFilterTags(relatedEntity.Tag.DoesNotContainAny),
+			})
+		}
+		entity["tag"] = tags
+		relatedEntities = append(relatedEntities, entity)
+	}
+	filter["related_entity"] = relatedEntities
+	filter["control"] = controls
+
+	output = append(output, filter)
+	return output, nil
+}
+
+// flattenAutomationRuleConfigurationFindingFilter converts the configuration finding filters returned by the API to the structured filter block
+func flattenAutomationRuleConfigurationFindingFilter(filters json.RawMessage) ([]interface{}, error) {
+	configurationFindingFilters := &wiz.ConfigurationFindingFilters{}
+	err := json.Unmarshal(filters, configurationFindingFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules = make([]interface{}, 0, 0)
+	if rule := configurationFindingFilters.Rule; rule != nil && !reflect.DeepEqual(*rule, wiz.CloudConfigurationRuleFilters{}) {
+		rules = append(rules, map[string]interface{}{
+			"ids":                   utils.ConvertSliceToGenericArray(rule.ID),
+			"search":                rule.Search,
+			"cloud_provider":        utils.ConvertSliceToGenericArray(rule.CloudProvider),
+			"service_type":          utils.ConvertSliceToGenericArray(rule.ServiceType),
+			"benchmark":             utils.ConvertSliceToGenericArray(rule.Benchmark),
+			"security_framework":    utils.ConvertSliceToGenericArray(rule.SecurityFramework),
+			"security_sub_category": utils.ConvertSliceToGenericArray(rule.SecuritySubCategory),
+		})
+	}
+
+	var output = make([]interface{}, 0, 0)
+	output = append(output, map[string]interface{}{
+		"configuration_finding": []interface{}{
+			map[string]interface{}{
+				"search":   configurationFindingFilters.Search,
+				"severity": utils.ConvertSliceToGenericArray(configurationFindingFilters.Severity),
+				"project":  utils.ConvertSliceToGenericArray(configurationFindingFilters.Project),
+				"rule":     rules,
+			},
+		},
+	})
+	return output, nil
+}
+
+// flattenAutomationRuleCloudEventFilter converts the cloud event filters returned by the API to the structured filter block
+func flattenAutomationRuleCloudEventFilter(filters json.RawMessage) ([]interface{}, error) {
+	cloudEventFilters := &wiz.CloudEventFilters{}
+	err := json.Unmarshal(filters, cloudEventFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	var output = make([]interface{}, 0, 0)
+	output = append(output, map[string]interface{}{
+		"cloud_event": []interface{}{
+			map[string]interface{}{
+				"cloud_platform":  utils.ConvertSliceToGenericArray(cloudEventFilters.CloudPlatform),
+				"severity":        utils.ConvertSliceToGenericArray(cloudEventFilters.Severity),
+				"project":         utils.ConvertSliceToGenericArray(cloudEventFilters.Project),
+				"subscription_id": utils.ConvertSliceToGenericArray(cloudEventFilters.SubscriptionID),
+			},
+		},
+	})
+	return output, nil
+}
+
+func flattenAutomationRuleFilterTags(tags []wiz.IssueEntityTag) []interface{} {
+	var output = make([]interface{}, 0, 0)
+	for _, a := range tags {
+		output = append(output, map[string]interface{}{
+			"key":   a.Key,
+			"value": a.Value,
+		})
+	}
+	return output
+}
+
+// validateAutomationRuleTemplate validates the mustache template syntax used by automation rule action templates
+func validateAutomationRuleTemplate(i interface{}, k string) (warnings []string, errs []error) {
+	template, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errs
+	}
+
+	err := checkAutomationRuleTemplate(template)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%s contains an invalid template: %w", k, err))
+	}
+
+	return warnings, errs
+}
+
+// checkAutomationRuleTemplate returns an error describing the first template syntax error found
+func checkAutomationRuleTemplate(template string) error {
+	sections := make([]string, 0)
+	position := 0
+	for {
+		start := strings.Index(template[position:], "{{")
+		if start == -1 {
+			break
+		}
+		start += position
+
+		// triple mustache tags output unescaped values
+		open, closing := "{{", "}}"
+		if strings.HasPrefix(template[start:], "{{{") {
+			open, closing = "{{{", "}}}"
+		}
+		end := strings.Index(template[start+len(open):], closing)
+		if end == -1 {
+			return fmt.Errorf("unclosed tag at position %d", start)
+		}
+		end += start + len(open)
+		tag := strings.TrimSpace(template[start+len(open) : end])
+		position = end + len(closing)
+
+		if strings.Contains(tag, "{{") {
+			return fmt.Errorf("unexpected {{ inside tag at position %d", start)
+		}
+		if tag == "" {
+			return fmt.Errorf("empty tag at position %d", start)
+		}
+		if open == "{{{" {
+			continue
+		}
+
+		switch tag[0] {
+		case '#', '^':
+			name := strings.TrimSpace(tag[1:])
+			if name == "" {
+				return fmt.Errorf("section without a name at position %d", start)
+			}
+			sections = append(sections, name)
+		case '/':
+			name := strings.TrimSpace(tag[1:])
+			if len(sections) == 0 {
+				return fmt.Errorf("closing tag {{/%s}} at position %d has no matching section", name, start)
+			}
+			if sections[len(sections)-1] != name {
+				return fmt.Errorf("closing tag {{/%s}} at position %d does not match section {{#%s}}", name, start, sections[len(sections)-1])
+			}
+			sections = sections[:len(sections)-1]
+		case '!':
+			// comments are ignored
+		default:
+			if strings.TrimSpace(strings.TrimLeft(tag, "&>")) == "" {
+				return fmt.Errorf("tag without a name at position %d", start)
+			}
+		}
+	}
+
+	if len(sections) > 0 {
+		return fmt.Errorf("section {{#%s}} is not closed", sections[len(sections)-1])
+	}
+
+	return nil
+}
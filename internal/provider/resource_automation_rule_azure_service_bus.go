@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRuleAzureServiceBus() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_azure_service_bus",
+		templateName: "azure_service_bus",
+		prefix:       "azure_service_bus_",
+		integration:  "Must be resource type integration_azure_service_bus.",
+	})
+}
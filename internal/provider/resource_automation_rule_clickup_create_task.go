@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+// clickUpCreateTaskBody struct
+type clickUpCreateTaskBody struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Priority    int      `json:"priority,omitempty"`
+}
+
+func resourceWizAutomationRuleClickUpCreateTask() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_clickup_create_task",
+		templateName: "clickup_create_task",
+		prefix:       "clickup_",
+		integration:  "Must be resource type integration_clickup.",
+		schema: map[string]*schema.Schema{
+			"clickup_task_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          automationRuleDefaultTicketSummary,
+				Description:      "Task name. Supports templated content; the template syntax is validated during plan.",
+				ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+			},
+			"clickup_task_description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          automationRuleDefaultTicketDescription,
+				Description:      "Task description. Supports templated content; the template syntax is validated during plan.",
+				ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+			},
+			"clickup_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Task tags.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"clickup_priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Task priority: `1` (urgent), `2` (high), `3` (normal) or `4` (low). Tasks are created without a priority when not set.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IntBetween(1, 4),
+				),
+			},
+			"clickup_body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ClickUp task request body rendered from the `clickup_task_*`, `clickup_tags` and `clickup_priority` attributes.",
+			},
+		},
+		expand:  expandClickUpCreateTask,
+		flatten: flattenClickUpCreateTask,
+	})
+}
+
+// expandClickUpCreateTask returns the clickup_create_task action template block
+func expandClickUpCreateTask(ctx context.Context, d *schema.ResourceData) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"list_id": d.Get("clickup_list_id").(string),
+		"body":    getClickUpCreateTaskBody(ctx, d),
+	}, nil
+}
+
+// flattenClickUpCreateTask sets the clickup_* attributes from the clickup_create_task action template block
+func flattenClickUpCreateTask(ctx context.Context, d *schema.ResourceData, template map[string]interface{}) error {
+	err := d.Set("clickup_list_id", template["list_id"])
+	if err != nil {
+		return err
+	}
+	err = d.Set("clickup_body", template["body"])
+	if err != nil {
+		return err
+	}
+	body := &clickUpCreateTaskBody{}
+	err = json.Unmarshal([]byte(template["body"].(string)), body)
+	if err != nil {
+		return err
+	}
+	err = d.Set("clickup_task_name", body.Name)
+	if err != nil {
+		return err
+	}
+	err = d.Set("clickup_task_description", body.Description)
+	if err != nil {
+		return err
+	}
+	err = d.Set("clickup_tags", body.Tags)
+	if err != nil {
+		return err
+	}
+	return d.Set("clickup_priority", body.Priority)
+}
+
+// getClickUpCreateTaskBody renders the ClickUp create task request body
+func getClickUpCreateTaskBody(ctx context.Context, d *schema.ResourceData) string {
+	tflog.Info(ctx, "getClickUpCreateTaskBody called...")
+
+	body := clickUpCreateTaskBody{
+		Name:        d.Get("clickup_task_name").(string),
+		Description: d.Get("clickup_task_description").(string),
+		Tags:        utils.ConvertListToString(d.Get("clickup_tags").([]interface{})),
+		Priority:    d.Get("clickup_priority").(int),
+	}
+	output, _ := json.Marshal(body)
+
+	return string(output)
+}
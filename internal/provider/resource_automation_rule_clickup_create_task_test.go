@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetClickUpCreateTaskBody(t *testing.T) {
+	ctx := context.Background()
+
+	expected := `{"name":"{{issue.control.name}}","description":"{{issue.description}}","tags":["wiz"],"priority":2}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleClickUpCreateTask().Schema,
+		map[string]interface{}{
+			"clickup_list_id":          "901",
+			"clickup_task_name":        "{{issue.control.name}}",
+			"clickup_task_description": "{{issue.description}}",
+			"clickup_tags": []interface{}{
+				"wiz",
+			},
+			"clickup_priority": 2,
+		},
+	)
+
+	body := getClickUpCreateTaskBody(ctx, d)
+
+	if body != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			body,
+			expected,
+		)
+	}
+
+	expected = `{"name":"Wiz Issue: {{control.name}}","description":"{{issue.description}}"}`
+
+	d = schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleClickUpCreateTask().Schema,
+		map[string]interface{}{
+			"clickup_list_id":          "901",
+			"clickup_task_description": "{{issue.description}}",
+		},
+	)
+
+	body = getClickUpCreateTaskBody(ctx, d)
+
+	if body != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			body,
+			expected,
+		)
+	}
+}
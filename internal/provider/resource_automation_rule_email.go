@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRuleEmail() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_email",
+		templateName: "email",
+		prefix:       "email_",
+		integration:  "Must be an email integration, such as the built-in Wiz email integration.",
+	})
+}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRuleGcpPubSub() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_gcp_pub_sub",
+		templateName: "gcp_pub_sub",
+		prefix:       "gcp_pub_sub_",
+		integration:  "Must be resource type integration_gcp_pub_sub.",
+	})
+}
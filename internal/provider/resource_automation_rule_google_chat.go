@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRuleGoogleChat() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_google_chat",
+		templateName: "google_chat",
+		prefix:       "google_chat_",
+		integration:  "Must be a Google Chat integration.",
+	})
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -77,12 +76,18 @@ func resourceWizAutomationRuleJiraAddComment() *schema.Resource {
 			},
 			"filters": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ExactlyOneOf: []string{
+					"filter",
+					"filters",
+				},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.StringIsJSON,
 				),
-				Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI.",
+				Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI. When `filter` is used, this is computed from the structured filter.",
 			},
+			"filter": automationRuleFilterSchema(),
 			"enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -122,6 +127,7 @@ func resourceWizAutomationRuleJiraAddComment() *schema.Resource {
 				Description: "Whether or not to attach a report on all open issues as an attachment to ticket, only relevant in CONTROL triggered actions",
 			},
 		},
+		CustomizeDiff: automationRuleFiltersCustomizeDiff,
 		CreateContext: resourceWizAutomationRuleJiraAddCommentCreate,
 		ReadContext:   resourceWizAutomationRuleJiraAddCommentRead,
 		UpdateContext: resourceWizAutomationRuleJiraAddCommentUpdate,
@@ -153,7 +159,7 @@ func resourceWizAutomationRuleJiraAddCommentCreate(ctx context.Context, d *schem
 	vars.Name = d.Get("name").(string)
 	vars.Description = d.Get("description").(string)
 	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
-	vars.Filters = json.RawMessage(d.Get("filters").(string))
+	vars.Filters = getAutomationRuleFilters(ctx, d)
 	vars.ProjectID = d.Get("project_id").(string)
 	vars.TriggerType = utils.ConvertListToString(d.Get("trigger_type").([]interface{}))
 	vars.TriggerSource = d.Get("trigger_source").(string)
@@ -287,6 +293,16 @@ func resourceWizAutomationRuleJiraAddCommentRead(ctx context.Context, d *schema.
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	if len(d.Get("filter").([]interface{})) > 0 {
+		filter, err := flattenAutomationRuleFilter(ctx, data.AutomationRule.TriggerSource, data.AutomationRule.Filters)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("filter", filter)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
 	err = d.Set("project_id", data.AutomationRule.Project.ID)
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -353,7 +369,7 @@ func resourceWizAutomationRuleJiraAddCommentUpdate(ctx context.Context, d *schem
 		triggerTypes = append(triggerTypes, j.(string))
 	}
 	vars.Patch.TriggerType = triggerTypes
-	vars.Patch.Filters = json.RawMessage(d.Get("filters").(string))
+	vars.Patch.Filters = getAutomationRuleFilters(ctx, d)
 	vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
 
 	actions := []wiz.AutomationRuleActionInput{}
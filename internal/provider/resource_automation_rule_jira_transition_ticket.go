@@ -77,12 +77,18 @@ func resourceWizAutomationRuleJiraTransitionTicket() *schema.Resource {
 			},
 			"filters": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ExactlyOneOf: []string{
+					"filter",
+					"filters",
+				},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.StringIsJSON,
 				),
-				Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI.",
+				Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI. When `filter` is used, this is computed from the structured filter.",
 			},
+			"filter": automationRuleFilterSchema(),
 			"enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -140,6 +146,7 @@ func resourceWizAutomationRuleJiraTransitionTicket() *schema.Resource {
 				Description: "Upload issues report as attachment Only relevant in CONTROL-triggered Actions.",
 			},
 		},
+		CustomizeDiff: automationRuleFiltersCustomizeDiff,
 		CreateContext: resourceWizAutomationRuleJiraTransitionTicketCreate,
 		ReadContext:   resourceWizAutomationRuleJiraTransitionTicketRead,
 		UpdateContext: resourceWizAutomationRuleJiraTransitionTicketUpdate,
@@ -171,7 +178,7 @@ func resourceWizAutomationRuleJiraTransitionTicketCreate(ctx context.Context, d
 	vars.Name = d.Get("name").(string)
 	vars.Description = d.Get("description").(string)
 	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
-	vars.Filters = json.RawMessage(d.Get("filters").(string))
+	vars.Filters = getAutomationRuleFilters(ctx, d)
 	vars.ProjectID = d.Get("project_id").(string)
 	vars.TriggerType = utils.ConvertListToString(d.Get("trigger_type").([]interface{}))
 	vars.TriggerSource = d.Get("trigger_source").(string)
@@ -311,6 +318,16 @@ func resourceWizAutomationRuleJiraTransitionTicketRead(ctx context.Context, d *s
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	if len(d.Get("filter").([]interface{})) > 0 {
+		filter, err := flattenAutomationRuleFilter(ctx, data.AutomationRule.TriggerSource, data.AutomationRule.Filters)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("filter", filter)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
 	err = d.Set("project_id", data.AutomationRule.Project.ID)
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -389,7 +406,7 @@ func resourceWizAutomationRuleJiraTransitionTicketUpdate(ctx context.Context, d
 		triggerTypes = append(triggerTypes, j.(string))
 	}
 	vars.Patch.TriggerType = triggerTypes
-	vars.Patch.Filters = json.RawMessage(d.Get("filters").(string))
+	vars.Patch.Filters = getAutomationRuleFilters(ctx, d)
 	vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
 
 	actions := []wiz.AutomationRuleActionInput{}
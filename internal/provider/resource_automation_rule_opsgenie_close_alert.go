@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// opsgenieCloseAlertBody struct
+type opsgenieCloseAlertBody struct {
+	Alias string `json:"alias"`
+	Note  string `json:"note,omitempty"`
+}
+
+func resourceWizAutomationRuleOpsgenieCloseAlert() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_opsgenie_close_alert",
+		templateName: "opsgenie_close_alert",
+		prefix:       "opsgenie_",
+		integration:  "Must be resource type integration_opsgenie.",
+		schema: map[string]*schema.Schema{
+			"opsgenie_alias": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          opsgenieDefaultAlias,
+				Description:      "Alias of the alert to close. Must match the alias of `wiz_automation_rule_opsgenie_create_alert`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+			},
+			"opsgenie_note": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Note to add to the alert when closing it. Supports templated content; the template syntax is validated during plan.",
+				ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+			},
+			"opsgenie_body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Opsgenie close alert request body rendered from the `opsgenie_*` attributes.",
+			},
+		},
+		expand:  expandOpsgenieCloseAlert,
+		flatten: flattenOpsgenieCloseAlert,
+	})
+}
+
+// expandOpsgenieCloseAlert returns the opsgenie_close_alert action template block
+func expandOpsgenieCloseAlert(ctx context.Context, d *schema.ResourceData) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"body": getOpsgenieCloseAlertBody(ctx, d),
+	}, nil
+}
+
+// flattenOpsgenieCloseAlert sets the opsgenie_* attributes from the opsgenie_close_alert action template block
+func flattenOpsgenieCloseAlert(ctx context.Context, d *schema.ResourceData, template map[string]interface{}) error {
+	err := d.Set("opsgenie_body", template["body"])
+	if err != nil {
+		return err
+	}
+	body := &opsgenieCloseAlertBody{}
+	err = json.Unmarshal([]byte(template["body"].(string)), body)
+	if err != nil {
+		return err
+	}
+	err = d.Set("opsgenie_alias", body.Alias)
+	if err != nil {
+		return err
+	}
+	return d.Set("opsgenie_note", body.Note)
+}
+
+// getOpsgenieCloseAlertBody renders the Opsgenie close alert request body
+func getOpsgenieCloseAlertBody(ctx context.Context, d *schema.ResourceData) string {
+	tflog.Info(ctx, "getOpsgenieCloseAlertBody called...")
+
+	body := opsgenieCloseAlertBody{
+		Alias: d.Get("opsgenie_alias").(string),
+		Note:  d.Get("opsgenie_note").(string),
+	}
+	output, _ := json.Marshal(body)
+
+	return string(output)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// opsgenieDefaultAlias is the default alert alias, shared by the create and close alert rules
+const opsgenieDefaultAlias = "{{issue.id}}"
+
+// opsgeniePriorities lists the Opsgenie alert priorities
+var opsgeniePriorities = []string{
+	"P1",
+	"P2",
+	"P3",
+	"P4",
+	"P5",
+}
+
+// opsgenieDefaultPriorityMapping maps issue severities to Opsgenie alert priorities
+var opsgenieDefaultPriorityMapping = map[string]string{
+	"CRITICAL":      "P1",
+	"HIGH":          "P2",
+	"MEDIUM":        "P3",
+	"LOW":           "P4",
+	"INFORMATIONAL": "P5",
+}
+
+// opsgenieAlertDetails are the alert details rendered for each issue, Opsgenie alert policies can match them to set the priority
+var opsgenieAlertDetails = map[string]string{
+	"severity": "{{issue.severity}}",
+}
+
+// opsgenieCreateAlertBody struct
+type opsgenieCreateAlertBody struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Details     map[string]string `json:"details,omitempty"`
+	Priority    string            `json:"priority,omitempty"`
+}
+
+func resourceWizAutomationRuleOpsgenieCreateAlert() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_opsgenie_create_alert",
+		templateName: "opsgenie_create_alert",
+		prefix:       "opsgenie_",
+		integration:  "Must be resource type integration_opsgenie.",
+		schema: map[string]*schema.Schema{
+			"opsgenie_message": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          automationRuleDefaultTicketSummary,
+				Description:      "Alert message. Supports templated content; the template syntax is validated during plan.",
+				ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+			},
+			"opsgenie_description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          automationRuleDefaultTicketDescription,
+				Description:      "Alert description. Supports templated content; the template syntax is validated during plan.",
+				ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+			},
+			"opsgenie_alias": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          opsgenieDefaultAlias,
+				Description:      "Alert alias used by Opsgenie for de-duplication. Must match the alias of `wiz_automation_rule_opsgenie_close_alert` to close the alert on resolution.",
+				ValidateDiagFunc: validation.ToDiagFunc(validateAutomationRuleTemplate),
+			},
+			"opsgenie_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Alert tags.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"opsgenie_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Alert priority applied to every alert, overrides `opsgenie_priority_mapping`.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						opsgeniePriorities,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						opsgeniePriorities,
+						false,
+					),
+				),
+			},
+			"opsgenie_priority_mapping": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Mapping of issue severity to alert priority, rendered into the alert body when `opsgenie_priority` is not set. The priority is mapped from the most severe value of the rule's severity filter; without a severity filter the priority is left to Opsgenie, which defaults to `P3`. Keys are severities, values are priorities, and unset severities use the defaults: `CRITICAL` = `P1`, `HIGH` = `P2`, `MEDIUM` = `P3`, `LOW` = `P4`, `INFORMATIONAL` = `P5`. Every alert also carries the issue severity in its `severity` detail.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validation.AllDiag(
+					validation.MapKeyMatch(regexp.MustCompile(fmt.Sprintf("^(%s)$", strings.Join(wiz.Severity, "|"))), "must be an issue severity"),
+					validation.MapValueMatch(regexp.MustCompile(fmt.Sprintf("^(%s)$", strings.Join(opsgeniePriorities, "|"))), "must be an Opsgenie priority"),
+				),
+			},
+			"opsgenie_body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Opsgenie alert request body rendered from the `opsgenie_*` attributes.",
+			},
+		},
+		expand:  expandOpsgenieCreateAlert,
+		flatten: flattenOpsgenieCreateAlert,
+	})
+}
+
+// expandOpsgenieCreateAlert returns the opsgenie_create_alert action template block
+func expandOpsgenieCreateAlert(ctx context.Context, d *schema.ResourceData) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"body": getOpsgenieCreateAlertBody(ctx, d),
+	}, nil
+}
+
+// flattenOpsgenieCreateAlert sets the opsgenie_* attributes from the opsgenie_create_alert action template block
+func flattenOpsgenieCreateAlert(ctx context.Context, d *schema.ResourceData, template map[string]interface{}) error {
+	err := d.Set("opsgenie_body", template["body"])
+	if err != nil {
+		return err
+	}
+	body := &opsgenieCreateAlertBody{}
+	err = json.Unmarshal([]byte(template["body"].(string)), body)
+	if err != nil {
+		return err
+	}
+	err = d.Set("opsgenie_message", body.Message)
+	if err != nil {
+		return err
+	}
+	err = d.Set("opsgenie_description", body.Description)
+	if err != nil {
+		return err
+	}
+	err = d.Set("opsgenie_alias", body.Alias)
+	if err != nil {
+		return err
+	}
+	err = d.Set("opsgenie_tags", body.Tags)
+	if err != nil {
+		return err
+	}
+	// a priority mapped from the severity filter is not an override
+	if body.Priority == getOpsgeniePriority(ctx, d.Get("opsgenie_priority_mapping").(map[string]interface{}), getAutomationRuleFilterSeverities(ctx, d)) {
+		return nil
+	}
+	return d.Set("opsgenie_priority", body.Priority)
+}
+
+// getOpsgenieCreateAlertBody renders the Opsgenie create alert request body
+func getOpsgenieCreateAlertBody(ctx context.Context, d *schema.ResourceData) string {
+	tflog.Info(ctx, "getOpsgenieCreateAlertBody called...")
+
+	body := opsgenieCreateAlertBody{
+		Message:     d.Get("opsgenie_message").(string),
+		Alias:       d.Get("opsgenie_alias").(string),
+		Description: d.Get("opsgenie_description").(string),
+		Tags:        utils.ConvertListToString(d.Get("opsgenie_tags").([]interface{})),
+		Details:     opsgenieAlertDetails,
+		Priority:    d.Get("opsgenie_priority").(string),
+	}
+	if body.Priority == "" {
+		body.Priority = getOpsgeniePriority(ctx, d.Get("opsgenie_priority_mapping").(map[string]interface{}), getAutomationRuleFilterSeverities(ctx, d))
+	}
+	output, _ := json.Marshal(body)
+
+	return string(output)
+}
+
+// getOpsgeniePriority returns the priority mapped from the most severe of the given severities, or an empty string without severities
+func getOpsgeniePriority(ctx context.Context, mapping map[string]interface{}, severities []string) string {
+	tflog.Info(ctx, "getOpsgeniePriority called...")
+
+	// find the most severe value, wiz.Severity is ordered from least to most severe
+	mostSevere := -1
+	for _, severity := range severities {
+		for i, s := range wiz.Severity {
+			if s == severity && i > mostSevere {
+				mostSevere = i
+			}
+		}
+	}
+	if mostSevere == -1 {
+		return ""
+	}
+
+	severity := wiz.Severity[mostSevere]
+	if priority, ok := mapping[severity]; ok {
+		return priority.(string)
+	}
+	return opsgenieDefaultPriorityMapping[severity]
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetOpsgeniePriority(t *testing.T) {
+	ctx := context.Background()
+
+	priority := getOpsgeniePriority(ctx, map[string]interface{}{}, []string{"MEDIUM", "CRITICAL", "LOW"})
+	if priority != "P1" {
+		t.Fatalf("Got: %s Expected: %s", priority, "P1")
+	}
+
+	priority = getOpsgeniePriority(ctx, map[string]interface{}{"HIGH": "P1"}, []string{"HIGH", "LOW"})
+	if priority != "P1" {
+		t.Fatalf("Got: %s Expected: %s", priority, "P1")
+	}
+
+	priority = getOpsgeniePriority(ctx, map[string]interface{}{}, []string{"LOW"})
+	if priority != "P4" {
+		t.Fatalf("Got: %s Expected: %s", priority, "P4")
+	}
+
+	priority = getOpsgeniePriority(ctx, map[string]interface{}{}, nil)
+	if priority != "" {
+		t.Fatalf("Got: %s Expected no priority", priority)
+	}
+}
+
+func TestGetOpsgenieCreateAlertBody(t *testing.T) {
+	ctx := context.Background()
+
+	expected := `{"message":"{{issue.control.name}}","alias":"{{issue.id}}","description":"{{issue.description}}","tags":["wiz"],"details":{"severity":"{{issue.severity}}"},"priority":"P2"}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleOpsgenieCreateAlert().Schema,
+		map[string]interface{}{
+			"opsgenie_message":     "{{issue.control.name}}",
+			"opsgenie_description": "{{issue.description}}",
+			"opsgenie_tags": []interface{}{
+				"wiz",
+			},
+			"opsgenie_priority": "P2",
+		},
+	)
+
+	body := getOpsgenieCreateAlertBody(ctx, d)
+
+	if body != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			body,
+			expected,
+		)
+	}
+
+	expected = `{"message":"Wiz Issue: {{control.name}}","alias":"{{issue.id}}","description":"{{issue.description}}","details":{"severity":"{{issue.severity}}"},"priority":"P1"}`
+
+	d = schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleOpsgenieCreateAlert().Schema,
+		map[string]interface{}{
+			"opsgenie_description": "{{issue.description}}",
+			"filters":              `{"severity":["MEDIUM","HIGH"]}`,
+			"opsgenie_priority_mapping": map[string]interface{}{
+				"HIGH": "P1",
+			},
+		},
+	)
+
+	body = getOpsgenieCreateAlertBody(ctx, d)
+
+	if body != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			body,
+			expected,
+		)
+	}
+}
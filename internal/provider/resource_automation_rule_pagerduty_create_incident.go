@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRulePagerDutyCreateIncident() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_pagerduty_create_incident",
+		templateName: "pagerduty_create_incident",
+		prefix:       "pagerduty_",
+		integration:  "Must be resource type integration_pagerduty.",
+	})
+}
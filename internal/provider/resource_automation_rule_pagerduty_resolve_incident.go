@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRulePagerDutyResolveIncident() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_pagerduty_resolve_incident",
+		templateName: "pagerduty_resolve_incident",
+		prefix:       "pagerduty_",
+		integration:  "Must be resource type integration_pagerduty.",
+	})
+}
@@ -77,12 +77,18 @@ func resourceWizAutomationRuleServiceNowCreateTicket() *schema.Resource {
 			},
 			"filters": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ExactlyOneOf: []string{
+					"filter",
+					"filters",
+				},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.StringIsJSON,
 				),
-				Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI.",
+				Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI. When `filter` is used, this is computed from the structured filter.",
 			},
+			"filter": automationRuleFilterSchema(),
 			"enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -129,7 +135,7 @@ func resourceWizAutomationRuleServiceNowCreateTicket() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Ticket description",
-				Default:     `Description:  {{issue.description}}\nStatus:       {{issue.status}}\nCreated:      {{issue.createdAt}}\nSeverity:     {{issue.severity}}\nProject:      {{#issue.projects}}{{name}}, {{/issue.projects}}\n\n---\nResource:\t            {{issue.entitySnapshot.name}}\nType:\t                {{issue.entitySnapshot.nativeType}}\nCloud Platform:\t        {{issue.entitySnapshot.cloudPlatform}}\nCloud Resource URL:     {{issue.entitySnapshot.cloudProviderURL}}\nSubscription Name (ID): {{issue.entitySnapshot.subscriptionName}} ({{issue.entitySnapshot.subscriptionExternalId}})\nRegion:\t                {{issue.entitySnapshot.region}}\nPlease click the following link to proceed to investigate the issue:\nhttps://{{wizDomain}}/issues#~(issue~'{{issue.id}})\nSource Automation Rule: {{ruleName}}`,
+				Default:     automationRuleDefaultTicketDescription,
 			},
 			"servicenow_attach_evidence_csv": {
 				Type:        schema.TypeBool,
@@ -138,6 +144,7 @@ func resourceWizAutomationRuleServiceNowCreateTicket() *schema.Resource {
 				Description: "Upload issue evidence CSV as attachment?",
 			},
 		},
+		CustomizeDiff: automationRuleFiltersCustomizeDiff,
 		CreateContext: resourceWizAutomationRuleServiceNowCreateTicketCreate,
 		ReadContext:   resourceWizAutomationRuleServiceNowCreateTicketRead,
 		UpdateContext: resourceWizAutomationRuleServiceNowCreateTicketUpdate,
@@ -169,7 +176,7 @@ func resourceWizAutomationRuleServiceNowCreateTicketCreate(ctx context.Context,
 	vars.Name = d.Get("name").(string)
 	vars.Description = d.Get("description").(string)
 	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
-	vars.Filters = json.RawMessage(d.Get("filters").(string))
+	vars.Filters = getAutomationRuleFilters(ctx, d)
 	vars.ProjectID = d.Get("project_id").(string)
 	vars.TriggerType = utils.ConvertListToString(d.Get("trigger_type").([]interface{}))
 	vars.TriggerSource = d.Get("trigger_source").(string)
@@ -313,6 +320,16 @@ func resourceWizAutomationRuleServiceNowCreateTicketRead(ctx context.Context, d
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	if len(d.Get("filter").([]interface{})) > 0 {
+		filter, err := flattenAutomationRuleFilter(ctx, data.AutomationRule.TriggerSource, data.AutomationRule.Filters)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("filter", filter)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
 	err = d.Set("project_id", data.AutomationRule.Project.ID)
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -390,7 +407,7 @@ func resourceWizAutomationRuleServiceNowCreateTicketUpdate(ctx context.Context,
 		triggerTypes = append(triggerTypes, j.(string))
 	}
 	vars.Patch.TriggerType = triggerTypes
-	vars.Patch.Filters = json.RawMessage(d.Get("filters").(string))
+	vars.Patch.Filters = getAutomationRuleFilters(ctx, d)
 	vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
 
 	actions := []wiz.AutomationRuleActionInput{}
@@ -77,12 +77,18 @@ func resourceWizAutomationRuleServiceNowUpdateTicket() *schema.Resource {
 			},
 			"filters": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ExactlyOneOf: []string{
+					"filter",
+					"filters",
+				},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.StringIsJSON,
 				),
-				Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI.",
+				Description: "Value should be wrapped in jsonencode() to avoid diff detection. This is required even though the API states it is not required.  Validate is performed by the UI. When `filter` is used, this is computed from the structured filter.",
 			},
+			"filter": automationRuleFilterSchema(),
 			"enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -125,6 +131,7 @@ func resourceWizAutomationRuleServiceNowUpdateTicket() *schema.Resource {
 				Description: "Upload issues report as attachment Only relevant in CONTROL-triggered Actions.",
 			},
 		},
+		CustomizeDiff: automationRuleFiltersCustomizeDiff,
 		CreateContext: resourceWizAutomationRuleServiceNowUpdateTicketCreate,
 		ReadContext:   resourceWizAutomationRuleServiceNowUpdateTicketRead,
 		UpdateContext: resourceWizAutomationRuleServiceNowUpdateTicketUpdate,
@@ -156,7 +163,7 @@ func resourceWizAutomationRuleServiceNowUpdateTicketCreate(ctx context.Context,
 	vars.Name = d.Get("name").(string)
 	vars.Description = d.Get("description").(string)
 	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
-	vars.Filters = json.RawMessage(d.Get("filters").(string))
+	vars.Filters = getAutomationRuleFilters(ctx, d)
 	vars.ProjectID = d.Get("project_id").(string)
 	vars.TriggerType = utils.ConvertListToString(d.Get("trigger_type").([]interface{}))
 	vars.TriggerSource = d.Get("trigger_source").(string)
@@ -290,6 +297,16 @@ func resourceWizAutomationRuleServiceNowUpdateTicketRead(ctx context.Context, d
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	if len(d.Get("filter").([]interface{})) > 0 {
+		filter, err := flattenAutomationRuleFilter(ctx, data.AutomationRule.TriggerSource, data.AutomationRule.Filters)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("filter", filter)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
 	err = d.Set("project_id", data.AutomationRule.Project.ID)
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -356,7 +373,7 @@ func resourceWizAutomationRuleServiceNowUpdateTicketUpdate(ctx context.Context,
 		triggerTypes = append(triggerTypes, j.(string))
 	}
 	vars.Patch.TriggerType = triggerTypes
-	vars.Patch.Filters = json.RawMessage(d.Get("filters").(string))
+	vars.Patch.Filters = getAutomationRuleFilters(ctx, d)
 	vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
 
 	actions := []wiz.AutomationRuleActionInput{}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRuleSlack() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_slack",
+		templateName: "slack",
+		prefix:       "slack_",
+		integration:  "Must be resource type integration_slack.",
+	})
+}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRuleSlackBot() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_slack_bot",
+		templateName: "slack_bot",
+		prefix:       "slack_bot_",
+		integration:  "Must be resource type integration_slack_bot.",
+	})
+}
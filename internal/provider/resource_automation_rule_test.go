@@ -0,0 +1,887 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestGetAutomationRuleFilters(t *testing.T) {
+	ctx := context.Background()
+
+	expected := &wiz.IssueFilters{
+		Severity: []string{
+			"CRITICAL",
+			"HIGH",
+		},
+		Status: []string{
+			"OPEN",
+		},
+		SourceControl: []string{
+			"253702e2-4ef6-4f6f-af4b-f3eae38142c7",
+		},
+		RelatedEntity: &wiz.IssueEntityFilters{
+			CloudPlatform: []string{
+				"AWS",
+			},
+			SubscriptionID: []string{
+				"b95efbdb-ac2e-4deb-b9a7-23211f3a5d0a",
+			},
+			Tag: &wiz.IssueEntityTagFilter{
+				ContainsAny: []wiz.IssueEntityTag{
+					{
+						Key:   "environment",
+						Value: "production",
+					},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleAwsSns().Schema,
+		map[string]interface{}{
+			"name": "a0cc7ed8-2b4d-4a5b-bd69-cbf1d4d4a5c5",
+			"filter": []interface{}{
+				map[string]interface{}{
+					"severity": []interface{}{
+						"CRITICAL",
+						"HIGH",
+					},
+					"status": []interface{}{
+						"OPEN",
+					},
+					"source_control": []interface{}{
+						"253702e2-4ef6-4f6f-af4b-f3eae38142c7",
+					},
+					"related_entity": []interface{}{
+						map[string]interface{}{
+							"cloud_platform": []interface{}{
+								"AWS",
+							},
+							"subscription_id": []interface{}{
+								"b95efbdb-ac2e-4deb-b9a7-23211f3a5d0a",
+							},
+							"tag": []interface{}{
+								map[string]interface{}{
+									"contains_any": []interface{}{
+										map[string]interface{}{
+											"key":   "environment",
+											"value": "production",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+
+	filters := getAutomationRuleFilters(ctx, d)
+
+	actual := &wiz.IssueFilters{}
+	err := json.Unmarshal(filters, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actual,
+			expected,
+		)
+	}
+}
+
+func TestGetAutomationRuleFiltersJSON(t *testing.T) {
+	ctx := context.Background()
+
+	expected := `{"severity":["CRITICAL"]}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleAwsSns().Schema,
+		map[string]interface{}{
+			"name":    "a0cc7ed8-2b4d-4a5b-bd69-cbf1d4d4a5c5",
+			"filters": expected,
+		},
+	)
+
+	filters := getAutomationRuleFilters(ctx, d)
+
+	if string(filters) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			string(filters),
+			expected,
+		)
+	}
+}
+
+func TestFlattenAutomationRuleFilter(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"search": "",
+			"severity": []interface{}{
+				"CRITICAL",
+			},
+			"status":              []interface{}{},
+			"project":             []interface{}{},
+			"source_control":      []interface{}{},
+			"source_control_type": []interface{}{},
+			"security_category":   []interface{}{},
+			"security_sub_category": []interface{}{
+				"wsct-id-5",
+			},
+			"framework_category": []interface{}{},
+			"stack_layer":        []interface{}{},
+			"resolution_reason":  []interface{}{},
+			"risk_equals_any":    []interface{}{},
+			"risk_equals_all":    []interface{}{},
+			"control":            []interface{}{},
+			"related_entity": []interface{}{
+				map[string]interface{}{
+					"ids":  []interface{}{},
+					"type": "VIRTUAL_MACHINE",
+					"native_type": []interface{}{
+						"ec2",
+					},
+					"cloud_platform":    []interface{}{},
+					"region":            []interface{}{},
+					"subscription_id":   []interface{}{},
+					"resource_group_id": []interface{}{},
+					"status":            []interface{}{},
+					"tag": []interface{}{
+						map[string]interface{}{
+							"contains_all": []interface{}{},
+							"contains_any": []interface{}{},
+							"does_not_contain_all": []interface{}{
+								map[string]interface{}{
+									"key":   "ignore",
+									"value": "",
+								},
+							},
+							"does_not_contain_any": []interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	filters := json.RawMessage(`{
+		"severity": ["CRITICAL"],
+		"securitySubCategory": ["wsct-id-5"],
+		"relatedEntity": {
+			"type": "VIRTUAL_MACHINE",
+			"nativeType": ["ec2"],
+			"tag": {
+				"doesNotContainAll": [{"key": "ignore"}]
+			}
+		}
+	}`)
+
+	filter, err := flattenAutomationRuleFilter(ctx, "ISSUES", filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(filter, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			filter,
+			expected,
+		)
+	}
+}
+
+func TestFlattenAutomationRuleFilterEmptyRelatedEntity(t *testing.T) {
+	ctx := context.Background()
+
+	filter, err := flattenAutomationRuleFilter(ctx, "ISSUES", json.RawMessage(`{"project":[],"relatedEntity":{}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	relatedEntity := filter[0].(map[string]interface{})["related_entity"].([]interface{})
+	if len(relatedEntity) != 0 {
+		t.Fatalf("Expected no related_entity block, got %#v", relatedEntity)
+	}
+}
+
+func TestGetAutomationRuleFiltersControl(t *testing.T) {
+	ctx := context.Background()
+
+	expected := &wiz.ControlFilters{
+		Type:     []string{"SECURITY_GRAPH"},
+		Severity: "HIGH",
+		WithIssues: &wiz.IssueFilters{
+			Status: []string{"OPEN"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleAwsSns().Schema,
+		map[string]interface{}{
+			"name":           "a0cc7ed8-2b4d-4a5b-bd69-cbf1d4d4a5c5",
+			"trigger_source": "CONTROL",
+			"filter": []interface{}{
+				map[string]interface{}{
+					"status": []interface{}{
+						"OPEN",
+					},
+					"control": []interface{}{
+						map[string]interface{}{
+							"type": []interface{}{
+								"SECURITY_GRAPH",
+							},
+							"severity": "HIGH",
+						},
+					},
+				},
+			},
+		},
+	)
+
+	filters := getAutomationRuleFilters(ctx, d)
+
+	actual := &wiz.ControlFilters{}
+	err := json.Unmarshal(filters, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actual,
+			expected,
+		)
+	}
+
+	filter, err := flattenAutomationRuleFilter(ctx, "CONTROL", filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(filter[0].(map[string]interface{})["status"], []interface{}{"OPEN"}) {
+		t.Fatalf("Expected the issue criteria to be read from withIssues, got %#v", filter[0])
+	}
+	if len(filter[0].(map[string]interface{})["control"].([]interface{})) != 1 {
+		t.Fatalf("Expected a control block, got %#v", filter[0])
+	}
+}
+
+func TestGetAutomationRuleFiltersConfigurationFinding(t *testing.T) {
+	ctx := context.Background()
+
+	expected := &wiz.ConfigurationFindingFilters{
+		Severity: []string{"CRITICAL", "HIGH"},
+		Rule: &wiz.CloudConfigurationRuleFilters{
+			CloudProvider: []string{"AWS"},
+			Benchmark:     []string{"AWS_CIS_1_3_0"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleAwsSns().Schema,
+		map[string]interface{}{
+			"name":           "a0cc7ed8-2b4d-4a5b-bd69-cbf1d4d4a5c5",
+			"trigger_source": "CONFIGURATION_FINDING",
+			"filter": []interface{}{
+				map[string]interface{}{
+					"configuration_finding": []interface{}{
+						map[string]interface{}{
+							"severity": []interface{}{
+								"CRITICAL",
+								"HIGH",
+							},
+							"rule": []interface{}{
+								map[string]interface{}{
+									"cloud_provider": []interface{}{
+										"AWS",
+									},
+									"benchmark": []interface{}{
+										"AWS_CIS_1_3_0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+
+	filters := getAutomationRuleFilters(ctx, d)
+
+	actual := &wiz.ConfigurationFindingFilters{}
+	err := json.Unmarshal(filters, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actual,
+			expected,
+		)
+	}
+
+	filter, err := flattenAutomationRuleFilter(ctx, "CONFIGURATION_FINDING", filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedFilter := []interface{}{
+		map[string]interface{}{
+			"configuration_finding": []interface{}{
+				map[string]interface{}{
+					"search":   "",
+					"severity": []interface{}{"CRITICAL", "HIGH"},
+					"project":  []interface{}{},
+					"rule": []interface{}{
+						map[string]interface{}{
+							"ids":                   []interface{}{},
+							"search":                "",
+							"cloud_provider":        []interface{}{"AWS"},
+							"service_type":          []interface{}{},
+							"benchmark":             []interface{}{"AWS_CIS_1_3_0"},
+							"security_framework":    []interface{}{},
+							"security_sub_category": []interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(filter, expectedFilter) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			filter,
+			expectedFilter,
+		)
+	}
+}
+
+func TestGetAutomationRuleFiltersCloudEvent(t *testing.T) {
+	ctx := context.Background()
+
+	expected := &wiz.CloudEventFilters{
+		CloudPlatform:  []string{"AWS"},
+		Severity:       []string{"CRITICAL"},
+		SubscriptionID: []string{"ae3b1ec5-9ac0-4e5b-a2a2-43a0a1c6e4f1"},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRuleAwsSns().Schema,
+		map[string]interface{}{
+			"name":           "a0cc7ed8-2b4d-4a5b-bd69-cbf1d4d4a5c5",
+			"trigger_source": "CLOUD_EVENTS",
+			"filter": []interface{}{
+				map[string]interface{}{
+					"cloud_event": []interface{}{
+						map[string]interface{}{
+							"cloud_platform": []interface{}{
+								"AWS",
+							},
+							"severity": []interface{}{
+								"CRITICAL",
+							},
+							"subscription_id": []interface{}{
+								"ae3b1ec5-9ac0-4e5b-a2a2-43a0a1c6e4f1",
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+
+	filters := getAutomationRuleFilters(ctx, d)
+
+	actual := &wiz.CloudEventFilters{}
+	err := json.Unmarshal(filters, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actual,
+			expected,
+		)
+	}
+
+	filter, err := flattenAutomationRuleFilter(ctx, "CLOUD_EVENTS", filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedFilter := []interface{}{
+		map[string]interface{}{
+			"cloud_event": []interface{}{
+				map[string]interface{}{
+					"cloud_platform":  []interface{}{"AWS"},
+					"severity":        []interface{}{"CRITICAL"},
+					"project":         []interface{}{},
+					"subscription_id": []interface{}{"ae3b1ec5-9ac0-4e5b-a2a2-43a0a1c6e4f1"},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(filter, expectedFilter) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			filter,
+			expectedFilter,
+		)
+	}
+}
+
+func TestGetAutomationRuleActions(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []wiz.AutomationRuleActionInput{
+		{
+			IntegrationID:      "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			ActionTemplateType: "AWS_SNS",
+			ActionTemplateParams: wiz.ActionTemplateParamsInput{
+				AwsSNS: &wiz.AwsSNSActionTemplateParamsInput{
+					Body: "{{issue.id}}",
+				},
+			},
+		},
+		{
+			IntegrationID:      "2a5f7a3e-1f44-4a6a-9e09-3f5f1f5b0e2b",
+			ActionTemplateType: "WEBHOOK",
+			ActionTemplateParams: wiz.ActionTemplateParamsInput{
+				Webhook: &wiz.WebhookActionTemplateParamsInput{
+					Body: "{{issue.id}}",
+					Headers: []wiz.WebhookHeaderInput{
+						{
+							Key:   "X-Source",
+							Value: "wiz",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRule().Schema,
+		map[string]interface{}{
+			"name": "a0cc7ed8-2b4d-4a5b-bd69-cbf1d4d4a5c5",
+			"action": []interface{}{
+				map[string]interface{}{
+					"integration_id": "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+					"aws_sns": []interface{}{
+						map[string]interface{}{
+							"body": "{{issue.id}}",
+						},
+					},
+				},
+				map[string]interface{}{
+					"integration_id": "2a5f7a3e-1f44-4a6a-9e09-3f5f1f5b0e2b",
+					"webhook": []interface{}{
+						map[string]interface{}{
+							"body": "{{issue.id}}",
+							"header": []interface{}{
+								map[string]interface{}{
+									"key":   "X-Source",
+									"value": "wiz",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+
+	actions := getAutomationRuleActions(ctx, d)
+
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actions,
+			expected,
+		)
+	}
+}
+
+func TestFlattenAutomationRuleActions(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":             "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			"integration_id": "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			"slack_bot": []interface{}{
+				map[string]interface{}{
+					"channel": "#security",
+					"note":    "",
+				},
+			},
+		},
+		map[string]interface{}{
+			"id":             "8d0f0b1a-67a3-4f8e-a1c3-2cb8c1e0a0e4",
+			"integration_id": "2a5f7a3e-1f44-4a6a-9e09-3f5f1f5b0e2b",
+			"servicenow_update_ticket": []interface{}{
+				map[string]interface{}{
+					"table_name":           "incident",
+					"fields":               `{"state":"2"}`,
+					"attach_issues_report": true,
+				},
+			},
+		},
+	}
+
+	actions := []*wiz.AutomationRuleAction{
+		{
+			ID:                 "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			ActionTemplateType: "SLACK_BOT",
+			Integration: wiz.Integration{
+				ID: "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			},
+			ActionTemplateParams: map[string]interface{}{
+				"slackBotChannel": "#security",
+				"note":            nil,
+			},
+		},
+		{
+			ID:                 "8d0f0b1a-67a3-4f8e-a1c3-2cb8c1e0a0e4",
+			ActionTemplateType: "SERVICE_NOW_UPDATE_TICKET",
+			Integration: wiz.Integration{
+				ID: "2a5f7a3e-1f44-4a6a-9e09-3f5f1f5b0e2b",
+			},
+			ActionTemplateParams: map[string]interface{}{
+				"tableName": "incident",
+				"serviceNowUpdateFields": map[string]interface{}{
+					"state": "2",
+				},
+				"attachIssuesReport": true,
+			},
+		},
+	}
+
+	flattened, diags := flattenAutomationRuleActions(ctx, actions, nil)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %#v", diags)
+	}
+
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			flattened,
+			expected,
+		)
+	}
+}
+
+func TestGetAutomationRuleActionInputsSwitchTemplate(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []wiz.AutomationRuleActionInput{
+		{
+			IntegrationID:      "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			ActionTemplateType: "SLACK_BOT",
+			ActionTemplateParams: wiz.ActionTemplateParamsInput{
+				SlackBot: &wiz.SlackBotActionTemplateParamsInput{
+					Channel: "#security",
+				},
+			},
+		},
+		{
+			ID:                 "8d0f0b1a-67a3-4f8e-a1c3-2cb8c1e0a0e4",
+			IntegrationID:      "2a5f7a3e-1f44-4a6a-9e09-3f5f1f5b0e2b",
+			ActionTemplateType: "SLACK_BOT",
+			ActionTemplateParams: wiz.ActionTemplateParamsInput{
+				SlackBot: &wiz.SlackBotActionTemplateParamsInput{
+					Channel: "#alerts",
+				},
+			},
+		},
+	}
+
+	// the first action switches from the slack block to the slack_bot block, the planned id and type still hold the prior values
+	actionConfigs := []interface{}{
+		map[string]interface{}{
+			"id":             "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			"type":           "",
+			"integration_id": "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			"slack":          []interface{}{},
+			"slack_bot": []interface{}{
+				map[string]interface{}{
+					"channel": "#security",
+					"note":    "",
+				},
+			},
+		},
+		map[string]interface{}{
+			"id":             "8d0f0b1a-67a3-4f8e-a1c3-2cb8c1e0a0e4",
+			"type":           "",
+			"integration_id": "2a5f7a3e-1f44-4a6a-9e09-3f5f1f5b0e2b",
+			"slack_bot": []interface{}{
+				map[string]interface{}{
+					"channel": "#alerts",
+					"note":    "",
+				},
+			},
+		},
+	}
+	priorActions := []interface{}{
+		map[string]interface{}{
+			"id":             "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			"type":           "SLACK_BOT",
+			"integration_id": "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			"slack": []interface{}{
+				map[string]interface{}{
+					"note": "",
+				},
+			},
+		},
+		map[string]interface{}{
+			"id":             "8d0f0b1a-67a3-4f8e-a1c3-2cb8c1e0a0e4",
+			"integration_id": "2a5f7a3e-1f44-4a6a-9e09-3f5f1f5b0e2b",
+			"slack_bot": []interface{}{
+				map[string]interface{}{
+					"channel": "#security",
+					"note":    "",
+				},
+			},
+		},
+	}
+
+	actions := getAutomationRuleActionInputs(ctx, actionConfigs, priorActions)
+
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actions,
+			expected,
+		)
+	}
+}
+
+func TestAutomationRuleActionsPagerDutyResolveIncident(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []wiz.AutomationRuleActionInput{
+		{
+			IntegrationID:      "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			ActionTemplateType: "PAGER_DUTY_RESOLVE_INCIDENT",
+		},
+	}
+
+	// the empty pagerduty_resolve_incident block is read as a nil element
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRule().Schema,
+		map[string]interface{}{
+			"name": "a0cc7ed8-2b4d-4a5b-bd69-cbf1d4d4a5c5",
+			"action": []interface{}{
+				map[string]interface{}{
+					"integration_id": "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+					"pagerduty_resolve_incident": []interface{}{
+						map[string]interface{}{},
+					},
+				},
+			},
+		},
+	)
+
+	actions := getAutomationRuleActions(ctx, d)
+
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actions,
+			expected,
+		)
+	}
+
+	flattened, diags := flattenAutomationRuleActions(ctx, []*wiz.AutomationRuleAction{
+		{
+			ID:                 "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			ActionTemplateType: "PAGER_DUTY_RESOLVE_INCIDENT",
+			Integration: wiz.Integration{
+				ID: "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			},
+		},
+	}, nil)
+	if len(diags) != 0 {
+		t.Fatalf("Unexpected diagnostics: %#v", diags)
+	}
+
+	expectedFlattened := []interface{}{
+		map[string]interface{}{
+			"id":             "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			"integration_id": "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			"pagerduty_resolve_incident": []interface{}{
+				map[string]interface{}{},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(flattened, expectedFlattened) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			flattened,
+			expectedFlattened,
+		)
+	}
+}
+
+func TestFlattenAutomationRuleActionsUnsupported(t *testing.T) {
+	ctx := context.Background()
+
+	actions := []*wiz.AutomationRuleAction{
+		{
+			ID:                 "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			ActionTemplateType: "TEAMS",
+			Integration: wiz.Integration{
+				ID: "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			},
+		},
+	}
+
+	flattened, diags := flattenAutomationRuleActions(ctx, actions, nil)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a single warning diagnostic, got %#v", diags)
+	}
+
+	action := flattened[0].(map[string]interface{})
+	if len(action) != 4 || action["type"] != "TEAMS" || action["template_params_json"] != "" {
+		t.Fatalf("Unexpected action: %#v", action)
+	}
+
+	priorActions := []interface{}{
+		map[string]interface{}{
+			"id":                   "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			"template_params_json": `{"teams":{"note":"{{issue.id}}"}}`,
+		},
+	}
+
+	flattened, diags = flattenAutomationRuleActions(ctx, actions, priorActions)
+	if len(diags) != 0 {
+		t.Fatalf("Unexpected diagnostics: %#v", diags)
+	}
+
+	action = flattened[0].(map[string]interface{})
+	if action["template_params_json"] != `{"teams":{"note":"{{issue.id}}"}}` {
+		t.Fatalf("Expected template_params_json to be carried over, got %#v", action)
+	}
+}
+
+func TestFlattenAutomationRuleActionsInvalidParams(t *testing.T) {
+	ctx := context.Background()
+
+	actions := []*wiz.AutomationRuleAction{
+		{
+			ID:                 "f1f9f3c4-37b2-4b0c-9d4e-7e6a7e9c2a10",
+			ActionTemplateType: "EMAIL",
+			Integration: wiz.Integration{
+				ID: "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			},
+			ActionTemplateParams: map[string]interface{}{
+				"to": "security@example.com",
+			},
+		},
+	}
+
+	_, diags := flattenAutomationRuleActions(ctx, actions, nil)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error diagnostic, got %#v", diags)
+	}
+}
+
+func TestGetAutomationRuleActionsTemplateParamsJSON(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []wiz.AutomationRuleActionInput{
+		{
+			IntegrationID:        "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+			ActionTemplateType:   "MICROSOFT_TEAMS",
+			ActionTemplateParams: json.RawMessage(`{"teams":{"note":"{{issue.id}}"}}`),
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizAutomationRule().Schema,
+		map[string]interface{}{
+			"name": "a0cc7ed8-2b4d-4a5b-bd69-cbf1d4d4a5c5",
+			"action": []interface{}{
+				map[string]interface{}{
+					"integration_id":       "7c3ca3d7-5a0b-4f2a-8a3e-0b58c5dd6fe3",
+					"type":                 "MICROSOFT_TEAMS",
+					"template_params_json": `{"teams":{"note":"{{issue.id}}"}}`,
+				},
+			},
+		},
+	)
+
+	actions := getAutomationRuleActions(ctx, d)
+
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actions,
+			expected,
+		)
+	}
+}
+
+func TestCheckAutomationRuleTemplate(t *testing.T) {
+	valid := []string{
+		`{"issue":{"id":"{{issue.id}}"}}`,
+		"{{#issue.projects}}{{name}}, {{/issue.projects}}",
+		"{{^issue.projects}}none{{/issue.projects}}",
+		"{{{issue.description}}} {{! comment }} {{&issue.name}}",
+		"no templates",
+	}
+	for _, template := range valid {
+		err := checkAutomationRuleTemplate(template)
+		if err != nil {
+			t.Fatalf("Expected template %q to be valid, got: %s", template, err)
+		}
+	}
+
+	invalid := []string{
+		"{{issue.id}",
+		"{{}}",
+		"{{#issue.projects}}{{name}}",
+		"{{#issue.projects}}{{name}}{{/issue.control}}",
+		"{{/issue.projects}}",
+		"{{issue.{{id}}",
+		"{{# }}",
+	}
+	for _, template := range invalid {
+		err := checkAutomationRuleTemplate(template)
+		if err == nil {
+			t.Fatalf("Expected template %q to be invalid", template)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWizAutomationRuleWebhook() *schema.Resource {
+	return resourceWizAutomationRuleAction(automationRuleAction{
+		resourceType: "automation_rule_webhook",
+		templateName: "webhook",
+		prefix:       "webhook_",
+		integration:  "Must be resource type integration_webhook.",
+	})
+}
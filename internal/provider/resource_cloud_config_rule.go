@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -78,10 +79,26 @@ func resourceWizCloudConfigurationRule() *schema.Resource {
 			"scope_account_ids": {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "Set the rule scope of cloud account IDs. Select only subscriptions matching to the rule cloud provider. To change scope to 'all relevant resources' set to empty array. This must be the Wiz internal identifier for the account(uuid format).",
+				Computed:    true,
+				Description: "Set the rule scope of cloud account IDs. Select only subscriptions matching to the rule cloud provider. To change scope to 'all relevant resources' set to empty array. This must be the Wiz internal identifier for the account(uuid format), it is resolved from `scope_account_external_ids` when not set.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ConflictsWith: []string{
+					"scope_account_external_ids",
+				},
+			},
+			"scope_account_external_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Set the rule scope by the cloud provider identifiers of the cloud accounts (AWS account IDs, GCP project IDs, Azure subscription IDs), resolved to `scope_account_ids` by the provider. An error is returned if an identifier matches no or several cloud accounts.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
+				ConflictsWith: []string{
+					"scope_account_ids",
+				},
 			},
 			"function_as_control": {
 				Type:        schema.TypeBool,
@@ -128,6 +145,7 @@ func resourceWizCloudConfigurationRule() *schema.Resource {
 				},
 			},
 		},
+		CustomizeDiff: scopeAccountIDsCustomizeDiff,
 		CreateContext: resourceWizCloudConfigurationRuleCreate,
 		ReadContext:   resourceWizCloudConfigurationRuleRead,
 		UpdateContext: resourceWizCloudConfigurationRuleUpdate,
@@ -138,6 +156,69 @@ func resourceWizCloudConfigurationRule() *schema.Resource {
 	}
 }
 
+// scopeAccountExternalIDsConfigured reports whether the rule scope is set by external identifiers
+func scopeAccountExternalIDsConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return false
+	}
+
+	externalIDs := rawConfig.GetAttr("scope_account_external_ids")
+	return !externalIDs.IsNull() && (!externalIDs.IsKnown() || externalIDs.LengthInt() > 0)
+}
+
+// scopeAccountIDsCustomizeDiff marks the scope attribute not set in the configuration as computed when the other one changes,
+// both attributes are computed so they are reset explicitly when the scope is removed from the configuration
+func scopeAccountIDsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	ids := rawConfig.GetAttr("scope_account_ids")
+	idsConfigured := !ids.IsNull() && (!ids.IsKnown() || ids.LengthInt() > 0)
+	externalIDs := rawConfig.GetAttr("scope_account_external_ids")
+	externalIDsConfigured := !externalIDs.IsNull() && (!externalIDs.IsKnown() || externalIDs.LengthInt() > 0)
+
+	switch {
+	case !idsConfigured && !externalIDsConfigured:
+		for _, attribute := range []string{"scope_account_ids", "scope_account_external_ids"} {
+			if d.Get(attribute).(*schema.Set).Len() > 0 {
+				err := d.SetNew(attribute, []interface{}{})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	case externalIDsConfigured && d.HasChange("scope_account_external_ids"):
+		return d.SetNewComputed("scope_account_ids")
+	case idsConfigured && d.HasChange("scope_account_ids"):
+		return d.SetNewComputed("scope_account_external_ids")
+	}
+
+	return nil
+}
+
+// expandScopeAccountIDs returns the Wiz identifiers of the rule scope, resolving the external identifiers when set
+func expandScopeAccountIDs(ctx context.Context, d *schema.ResourceData, m interface{}) ([]string, diag.Diagnostics) {
+	if !scopeAccountExternalIDsConfigured(d) {
+		return utils.ConvertListToString(d.Get("scope_account_ids").(*schema.Set).List()), nil
+	}
+
+	externalIDs := utils.ConvertListToString(d.Get("scope_account_external_ids").(*schema.Set).List())
+	resolved, diags := resolveCloudAccountExternalIDs(ctx, m, externalIDs)
+	if len(diags) > 0 {
+		return nil, diags
+	}
+
+	scopeAccountIDs := make([]string, 0, len(externalIDs))
+	for _, externalID := range externalIDs {
+		scopeAccountIDs = append(scopeAccountIDs, resolved[externalID])
+	}
+
+	return scopeAccountIDs, diags
+}
+
 func getIACMatchers(ctx context.Context, d *schema.ResourceData) []*wiz.CreateCloudConfigurationRuleMatcherInput {
 	tflog.Info(ctx, "getIACMatchers called...")
 
@@ -193,7 +274,12 @@ func resourceWizCloudConfigurationRuleCreate(ctx context.Context, d *schema.Reso
 	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
 	vars.RemediationInstructions = d.Get("remediation_instructions").(string)
 	vars.IACMatchers = getIACMatchers(ctx, d)
-	vars.ScopeAccountIDs = utils.ConvertListToString(d.Get("scope_account_ids").(*schema.Set).List())
+	scopeAccountIDs, resolveDiags := expandScopeAccountIDs(ctx, d, m)
+	diags = append(diags, resolveDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	vars.ScopeAccountIDs = scopeAccountIDs
 	vars.FunctionAsControl = utils.ConvertBoolToPointer(d.Get("function_as_control").(bool))
 
 	// process the request
@@ -249,6 +335,24 @@ func flattenScopeAccountIDs(ctx context.Context, scopeAccounts []*wiz.CloudAccou
 	return output
 }
 
+func flattenScopeAccountExternalIDs(ctx context.Context, scopeAccounts []*wiz.CloudAccount, configured []string) []interface{} {
+	tflog.Info(ctx, "flattenScopeAccountExternalIDs called...")
+
+	var output = make([]interface{}, 0, 0)
+	for _, b := range scopeAccounts {
+		externalID := b.ExternalID
+		// keep the configured value when it only differs by case
+		for _, c := range configured {
+			if strings.EqualFold(c, externalID) {
+				externalID = c
+			}
+		}
+		output = append(output, externalID)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("flattenScopeAccountExternalIDs output: %s", utils.PrettyPrint(output)))
+	return output
+}
+
 // ReadCloudConfigurationRulePayload struct -- updates
 type ReadCloudConfigurationRulePayload struct {
 	CloudConfigurationRule wiz.CloudConfigurationRule `json:"cloudConfigurationRule"`
@@ -279,6 +383,7 @@ func resourceWizCloudConfigurationRuleRead(ctx context.Context, d *schema.Resour
 	        remediationInstructions
 	        scopeAccounts {
 	            id
+	            externalId
 	        }
 	        functionAsControl
 	        securitySubCategories {
@@ -353,6 +458,10 @@ func resourceWizCloudConfigurationRuleRead(ctx context.Context, d *schema.Resour
 	if err := d.Set("scope_account_ids", scopeAccountIDs); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	scopeAccountExternalIDs := flattenScopeAccountExternalIDs(ctx, data.CloudConfigurationRule.ScopeAccounts, utils.ConvertListToString(d.Get("scope_account_external_ids").(*schema.Set).List()))
+	if err := d.Set("scope_account_external_ids", scopeAccountExternalIDs); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
 	securitySubCategories := flattenSecuritySubCategoriesID(ctx, data.CloudConfigurationRule.SecuritySubCategories)
 	if err := d.Set("security_sub_categories", securitySubCategories); err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -417,9 +526,10 @@ func resourceWizCloudConfigurationRuleUpdate(ctx context.Context, d *schema.Reso
 	vars.Patch.Severity = d.Get("severity").(string)
 	vars.Patch.FunctionAsControl = utils.ConvertBoolToPointer(d.Get("function_as_control").(bool))
 	// flatten scopeAccountIds
-	scopeAccountIds := make([]string, 0)
-	for _, j := range d.Get("scope_account_ids").(*schema.Set).List() {
-		scopeAccountIds = append(scopeAccountIds, j.(string))
+	scopeAccountIds, resolveDiags := expandScopeAccountIDs(ctx, d, m)
+	diags = append(diags, resolveDiags...)
+	if len(diags) > 0 {
+		return diags
 	}
 	vars.Patch.ScopeAccountIds = scopeAccountIds
 	// flatten iacMatchers
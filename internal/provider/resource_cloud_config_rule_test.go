@@ -167,3 +167,29 @@ func TestFlattenIACMatchers(t *testing.T) {
 		)
 	}
 }
+
+func TestFlattenScopeAccountExternalIDs(t *testing.T) {
+	ctx := context.Background()
+	expected := []interface{}{
+		"100000000009",
+		"6f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0",
+	}
+	var expanded = []*wiz.CloudAccount{
+		{
+			ID:         "c14e688d-62ca-42f0-bf27-01195288d4ec",
+			ExternalID: "100000000009",
+		},
+		{
+			ID:         "09f38436-80ac-493a-b554-e000e4f9cbd1",
+			ExternalID: "6F1E2D3C-4B5A-4968-8776-A5B4C3D2E1F0",
+		},
+	}
+	scopeAccountExternalIDs := flattenScopeAccountExternalIDs(ctx, expanded, []string{"6f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0"})
+	if !reflect.DeepEqual(scopeAccountExternalIDs, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			scopeAccountExternalIDs,
+			expected,
+		)
+	}
+}
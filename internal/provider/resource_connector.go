@@ -0,0 +1,525 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// connectorSchema returns the attributes shared by the connectors built from structured parameters merged with the connector specific attributes
+func connectorSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	connectorSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Wiz internal identifier for the connector.",
+			Computed:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The connector name.",
+			Required:    true,
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Description: "Whether the connector is enabled.",
+			Optional:    true,
+			Default:     true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: fmt.Sprintf(
+				"The connector status.\n    - Possible values: %s",
+				utils.SliceOfStringToMDUList(
+					wiz.ConnectorStatus,
+				),
+			),
+		},
+	}
+
+	for name, attribute := range attributes {
+		connectorSchema[name] = attribute
+	}
+
+	return connectorSchema
+}
+
+// connectorForceNewIfChange forces a new resource when any of the authentication attributes change
+// to accommodate for importing resources into state, we can't use `ForceNew` in the schema definition,
+// so the recreation only applies when the attribute was previously set in state.
+func connectorForceNewIfChange(authAttributes ...string) []schema.CustomizeDiffFunc {
+	var funcs []schema.CustomizeDiffFunc
+
+	for _, attribute := range authAttributes {
+		funcs = append(funcs, customdiff.ForceNewIfChange(attribute, func(ctx context.Context, old, new, meta any) bool {
+			if old.(string) != "" {
+				return old.(string) != new.(string)
+			}
+			return false
+		}))
+	}
+
+	return funcs
+}
+
+// createConnectorFromParams creates a connector from structured authentication parameters and extra configuration and sets the resource id
+func createConnectorFromParams(ctx context.Context, d *schema.ResourceData, m interface{}, connectorType string, authParams interface{}, extraConfig json.RawMessage) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "createConnectorFromParams called...")
+
+	query := `mutation CreateConnector($input: CreateConnectorInput!) {
+				createConnector(input: $input) {
+					connector {
+						id
+					}
+				}
+			  }
+		     `
+	// populate the graphql variables
+	vars := &wiz.CreateConnectorInput{}
+	vars.Name = d.Get("name").(string)
+	enabled := d.Get("enabled").(bool)
+	vars.Type = connectorType
+	vars.Enabled = &enabled
+
+	rawAuthParams, err := json.Marshal(authParams)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	vars.AuthParams = rawAuthParams
+	vars.ExtraConfig = extraConfig
+
+	// process the request
+	data := &CreateConnector{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "connector", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateConnector.Connector.ID)
+
+	return diags
+}
+
+// readConnectorFromParams reads a connector and sets the attributes from connectorSchema, a nil payload is returned if the connector no longer exists.
+// configFragment selects the fields of the connector type config union, pass an empty string when the config is not needed.
+func readConnectorFromParams(ctx context.Context, d *schema.ResourceData, m interface{}, configFragment string) (*ReadConnectorPayload, diag.Diagnostics) {
+	tflog.Info(ctx, "readConnectorFromParams called...")
+
+	var diags diag.Diagnostics
+
+	// define the graphql query
+	query := `query GetConnector($id: ID!) {
+	    connector(id: $id) {
+	      id
+	      name
+	      enabled
+	      status
+	      authParams
+	      extraConfig` + configFragment + `
+	      type {
+	        ...ConnectorTypeFrag
+	      }
+	    }
+	  }
+
+	  fragment ConnectorTypeFrag on ConnectorType {
+	    id
+	    name
+	    authorizeUrls
+	  }
+`
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadConnectorPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "connector", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+		// we are checking if the response does not have an ID and if so we mark the resource as new
+		if data.Connector.ID == "" {
+			tflog.Info(ctx, "resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil, nil
+		}
+		return nil, diags
+	}
+
+	err := d.Set("name", data.Connector.Name)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("id", data.Connector.ID)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("enabled", data.Connector.Enabled)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("status", data.Connector.Status)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	return data, diags
+}
+
+// setConnectorAuthAttributes sets the non-sensitive authentication attributes returned by Wiz, values that are not returned keep their state
+func setConnectorAuthAttributes(d *schema.ResourceData, attributes map[string]string) (diags diag.Diagnostics) {
+	for attribute, value := range attributes {
+		if value == "" {
+			continue
+		}
+		err := d.Set(attribute, value)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// setConnectorExtraConfig sets `extra_config` from the extra configuration returned by Wiz
+func setConnectorExtraConfig(d *schema.ResourceData, rawExtraConfig json.RawMessage) (diags diag.Diagnostics) {
+	var mapExtraConfig map[string]interface{}
+	err := json.Unmarshal(rawExtraConfig, &mapExtraConfig)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// When certain fields are deprecated, vendor returns null and/or empty string (i.e. cloudTrailConfig)
+	// We need to handle to avoid unwanted diffs, below traverses the map to a maximum depth of 5 levels
+	utils.RemoveNullAndEmptyValues(mapExtraConfig, 5)
+
+	extraConfig, err := json.Marshal(mapExtraConfig)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("extra_config", string(extraConfig))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// updateConnectorFromParams updates the name, enabled state and extra configuration of a connector, a nil extra configuration is left unchanged
+func updateConnectorFromParams(ctx context.Context, d *schema.ResourceData, m interface{}, extraConfig json.RawMessage) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "updateConnectorFromParams called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateConnector($input: UpdateConnectorInput!) {
+	    updateConnector(input: $input) {
+	      connector {
+	        id
+	        name
+	        enabled
+	        extraConfig
+	      }
+	    }
+	  }`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateConnectorInput{}
+	vars.ID = d.Id()
+
+	if d.HasChange("name") {
+		vars.Patch.Name = d.Get("name").(string)
+	}
+	if d.HasChange("enabled") {
+		enabled := d.Get("enabled").(bool)
+		vars.Patch.Enabled = &enabled
+	}
+	vars.Patch.ExtraConfig = extraConfig
+
+	// process the request
+	data := &UpdateConnector{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "connector", "update")
+	diags = append(diags, requestDiags...)
+
+	return diags
+}
+
+// resourceWizConnectorDelete deletes a connector
+func resourceWizConnectorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation DeleteConnector($input: DeleteConnectorInput!) {
+		deleteConnector(input: $input) {
+		  _stub
+		}
+	  }
+	`
+	// populate the graphql variables
+	vars := &wiz.DeleteConnectorInput{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &DeleteConnector{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "connector", "delete")
+	diags = append(diags, requestDiags...)
+
+	return diags
+}
+
+// connectorWaitStatus lists the wiz.ConnectorStatus values a connector can be waited for
+var connectorWaitStatus = []string{
+	"CONNECTED",
+	"PARTIALLY_CONNECTED",
+}
+
+const (
+	// connectorStatusError is the wiz.ConnectorStatus of a connector that failed to connect
+	connectorStatusError = "ERROR"
+	// connectorStatusPollInterval is the interval between connector status checks while waiting
+	connectorStatusPollInterval = 15 * time.Second
+)
+
+// connectorSettledStatus lists the wiz.ConnectorStatus values a connector stays in until its configuration or permissions change
+var connectorSettledStatus = []string{
+	"CONNECTED",
+	"PARTIALLY_CONNECTED",
+	connectorStatusError,
+	"DISABLED",
+}
+
+// connectorPendingStatus returns the wiz.ConnectorStatus values to keep waiting on, a connector settled in a status outside the target ends the wait
+func connectorPendingStatus() []string {
+	settledStatus := make(map[string]bool)
+	for _, status := range connectorSettledStatus {
+		settledStatus[status] = true
+	}
+
+	var pending []string
+	for _, status := range wiz.ConnectorStatus {
+		if settledStatus[status] {
+			continue
+		}
+		pending = append(pending, status)
+	}
+
+	return pending
+}
+
+// connectorErrorDiagnostic describes a connector that failed to connect
+func connectorErrorDiagnostic(severity diag.Severity, connector wiz.Connector) diag.Diagnostic {
+	errorCode := connector.ErrorCode
+	if errorCode == "" {
+		errorCode = "unknown"
+	}
+
+	return diag.Diagnostic{
+		Severity: severity,
+		Summary:  fmt.Sprintf("Connector %s failed to connect.", connector.Name),
+		Detail:   fmt.Sprintf("The connector %s is in the %s status with error code %s. Review the connector in the Wiz portal and the permissions granted by `auth_params`.", connector.ID, connector.Status, errorCode),
+	}
+}
+
+// connectorStatusRefreshFunc polls the status of a connector
+func connectorStatusRefreshFunc(ctx context.Context, m interface{}, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		tflog.Info(ctx, "connectorStatusRefreshFunc called...")
+
+		// define the graphql query
+		query := `query GetConnectorStatus($id: ID!) {
+		    connector(id: $id) {
+		      id
+		      name
+		      status
+		      errorCode
+		      cloudAccountCount
+		    }
+		  }`
+
+		// populate the graphql variables
+		vars := &internal.QueryVariables{}
+		vars.ID = id
+
+		// process the request
+		data := &ReadConnectorPayload{}
+		requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "connector", "read")
+		if requestDiags.HasError() {
+			var errs []string
+			for _, requestDiag := range requestDiags {
+				errs = append(errs, strings.TrimSpace(fmt.Sprintf("%s %s", requestDiag.Summary, requestDiag.Detail)))
+			}
+			return nil, "", fmt.Errorf("unable to read connector status: %s", strings.Join(errs, "; "))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("connector %s status: %s", id, data.Connector.Status))
+
+		return data.Connector, data.Connector.Status, nil
+	}
+}
+
+// waitForConnectorStatus waits for an enabled connector to reach one of the statuses in `wait_for_status`
+func waitForConnectorStatus(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "waitForConnectorStatus called...")
+
+	target := utils.ConvertListToString(d.Get("wait_for_status").(*schema.Set).List())
+	if len(target) == 0 || !d.Get("enabled").(bool) {
+		return nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:      connectorPendingStatus(),
+		Target:       target,
+		Refresh:      connectorStatusRefreshFunc(ctx, m, d.Id()),
+		Timeout:      timeout,
+		PollInterval: connectorStatusPollInterval,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		connector, ok := result.(wiz.Connector)
+		if ok && connector.Status == connectorStatusError {
+			return append(diags, connectorErrorDiagnostic(diag.Error, connector))
+		}
+		// the connector won't reach the target without a change, report its status rather than waiting for the timeout
+		var unexpectedStateErr *retry.UnexpectedStateError
+		if ok && errors.As(err, &unexpectedStateErr) {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Connector %s did not reach status %s.", connector.Name, strings.Join(target, " or ")),
+				Detail:   fmt.Sprintf("The connector %s settled in the %s status. Review the connector in the Wiz portal and the permissions granted by `auth_params`.", connector.ID, connector.Status),
+			})
+		}
+		return append(diags, diag.Errorf("error waiting for connector %s to reach status %s: %v", d.Id(), strings.Join(target, " or "), err)...)
+	}
+
+	return diags
+}
+
+// appendConnectorReadDiags appends the diagnostics of the read following a wait, skipping the warnings already reported by a failed wait
+func appendConnectorReadDiags(waitDiags diag.Diagnostics, readDiags diag.Diagnostics) diag.Diagnostics {
+	if !waitDiags.HasError() {
+		return append(waitDiags, readDiags...)
+	}
+
+	for _, readDiag := range readDiags {
+		if readDiag.Severity == diag.Error {
+			waitDiags = append(waitDiags, readDiag)
+		}
+	}
+
+	return waitDiags
+}
+
+// connectorExtraConfigAttributes returns the sorted attribute names of the structured extra configuration defaults
+func connectorExtraConfigAttributes(defaults map[string]interface{}) []string {
+	attributes := make([]string, 0, len(defaults))
+	for attribute := range defaults {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	return attributes
+}
+
+// connectorExtraConfigStructured reports whether the extra configuration is managed with the structured attributes rather than `extra_config`
+func connectorExtraConfigStructured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	return rawConfig.IsNull() || rawConfig.GetAttr("extra_config").IsNull()
+}
+
+// connectorConfiguredExtraConfigAttributesSchema returns the attribute recording the structured extra configuration attributes set in the configuration
+func connectorConfiguredExtraConfigAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "The structured extra configuration attributes set in the configuration. Only these attributes are reset to their defaults once removed from the configuration, settings never managed by Terraform are left unchanged.",
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// connectorExtraConfigCustomizeDiff resets the structured extra configuration attributes removed from the configuration to their defaults,
+// the attributes are computed so they report the server values for drift detection when not configured with `extra_config`.
+// Only the attributes recorded in `configured_extra_config_attributes` by a prior plan are reset, so the settings managed outside Terraform are kept.
+func connectorExtraConfigCustomizeDiff(defaults map[string]interface{}) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		rawConfig := d.GetRawConfig()
+		if rawConfig.IsNull() {
+			return nil
+		}
+
+		priorConfigured, _ := d.GetChange("configured_extra_config_attributes")
+		prior, _ := priorConfigured.(*schema.Set)
+		if prior == nil {
+			prior = schema.NewSet(schema.HashString, nil)
+		}
+		configured := schema.NewSet(schema.HashString, nil)
+
+		attributes := connectorExtraConfigAttributes(defaults)
+		structured := rawConfig.GetAttr("extra_config").IsNull()
+		if structured {
+			for _, attribute := range attributes {
+				if !rawConfig.GetAttr(attribute).IsNull() {
+					configured.Add(attribute)
+					continue
+				}
+				if !prior.Contains(attribute) {
+					continue
+				}
+				if reflect.DeepEqual(d.Get(attribute), defaults[attribute]) {
+					continue
+				}
+				tflog.Debug(ctx, fmt.Sprintf("resetting %s to its default", attribute))
+				err := d.SetNew(attribute, defaults[attribute])
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if !prior.Equal(configured) {
+			err := d.SetNew("configured_extra_config_attributes", configured)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the extra configuration is recomputed from the structured attributes
+		if structured && d.Id() != "" && d.HasChanges(attributes...) {
+			return d.SetNewComputed("extra_config")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorACR() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect Azure Container Registry (ACR) registries outside of connected Azure subscriptions to Wiz for image scanning.",
+		Schema: containerRegistryConnectorSchema("ACR", map[string]*schema.Schema{
+			"registry_url": {
+				Type:        schema.TypeString,
+				Description: "The ACR login server (e.g. `example.azurecr.io`).",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsNotEmpty,
+				),
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Description: "The Microsoft Entra tenant ID of the service principal.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The application (client) ID of a service principal with the `AcrPull` role on the registry.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "The client secret of the service principal.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		}),
+		CustomizeDiff: containerRegistryConnectorCustomizeDiff(
+			"registry_url",
+			"tenant_id",
+			"client_id",
+			"client_secret",
+		),
+		CreateContext: resourceWizConnectorACRCreate,
+		ReadContext:   resourceWizConnectorACRRead,
+		UpdateContext: resourceWizConnectorACRUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorACRCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorACRCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsACR{
+		RegistryURL:  d.Get("registry_url").(string),
+		TenantID:     d.Get("tenant_id").(string),
+		ClientID:     d.Get("client_id").(string),
+		ClientSecret: d.Get("client_secret").(string),
+	}
+
+	diags = createContainerRegistryConnector(ctx, d, m, "acr", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorACRRead(ctx, d, m)
+}
+
+func resourceWizConnectorACRRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorACRRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readContainerRegistryConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsACR
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsACR: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"registry_url": authParams.RegistryURL,
+		"tenant_id":    authParams.TenantID,
+		"client_id":    authParams.ClientID,
+	})
+}
+
+func resourceWizConnectorACRUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorACRUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateContainerRegistryConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorACRRead(ctx, d, m)
+}
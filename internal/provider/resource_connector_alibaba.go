@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// alibabaConnectorConfigFragment selects the Alibaba fields of the connector config union
+const alibabaConnectorConfigFragment = `
+	      config {
+	        ... on ConnectorConfigAlibaba {
+	          accountId
+	          resourceDirectoryId
+	          accessKeyId
+	          excludedAccounts
+	          diskAnalyzerInFlightDisabled
+	        }
+	      }`
+
+func resourceWizConnectorAlibaba() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect Alibaba Cloud resources to Wiz.",
+		Schema: connectorSchema(map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Description: "The Alibaba Cloud account ID.",
+				Computed:    true,
+			},
+			"resource_directory_id": {
+				Type:        schema.TypeString,
+				Description: "The Alibaba Cloud resource directory ID, when the connector is scoped to a resource directory.",
+				Computed:    true,
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Description: "The Alibaba Cloud AccessKey ID Wiz authenticates with.",
+				Computed:    true,
+			},
+			"excluded_accounts": {
+				Type:        schema.TypeList,
+				Description: "The Alibaba Cloud accounts excluded by the connector.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"disk_analyzer_inflight_disabled": {
+				Type:        schema.TypeBool,
+				Description: "If using Outpost, whether disk analyzer inflight scanning is disabled.",
+				Computed:    true,
+			},
+			"auth_params": {
+				Type:        schema.TypeString,
+				Description: "The authentication parameters. Must be represented in `JSON` format. Authenticate with a RAM user AccessKey using `accountId`, `accessKeyId` and `accessKeySecret`, and set `resourceDirectoryId` to connect a resource directory. If using outposts, also include `outPostId` and `diskAnalyzer` structure.",
+				Required:    true,
+				Sensitive:   true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+			"extra_config": {
+				// these are JSON fields; the schema does not support overrides, once a field is set, future changes require it to be passed
+				Type:        schema.TypeString,
+				Description: "Extra configuration for the connector. Must be represented in `JSON` format.",
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+		}),
+		// auth_params requires a resource recreation as they cannot be updated.
+		// to accommodate for importing resources into state, we can't use `ForceNew` in the schema definition.
+		// we use a customdiff and `ForceNewIfChange` for below attributes for only a change condition.
+		CustomizeDiff: customdiff.All(connectorForceNewIfChange("auth_params")...),
+		CreateContext: resourceWizConnectorAlibabaCreate,
+		ReadContext:   resourceWizConnectorAlibabaRead,
+		UpdateContext: resourceWizConnectorAlibabaUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorAlibabaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAlibabaCreate called...")
+
+	diags = createConnectorFromParams(ctx, d, m, "alibaba", json.RawMessage(d.Get("auth_params").(string)), json.RawMessage(d.Get("extra_config").(string)))
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorAlibabaRead(ctx, d, m)
+}
+
+func resourceWizConnectorAlibabaRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAlibabaRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readConnectorFromParams(ctx, d, m, alibabaConnectorConfigFragment)
+	if data == nil {
+		return diags
+	}
+
+	diags = append(diags, setConnectorExtraConfig(d, data.Connector.ExtraConfig)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var connectorConfig wiz.ConnectorConfigAlibaba
+	connectorConfigBytes, err := json.Marshal(data.Connector.Config)
+	if err != nil {
+		return append(diags, diag.Errorf("unable to marshal ConnectorConfigAlibaba: %v", err)...)
+	}
+	if err := json.Unmarshal(connectorConfigBytes, &connectorConfig); err != nil {
+		return append(diags, diag.Errorf("unable to unmarshal ConnectorConfigAlibaba: %v", err)...)
+	}
+
+	err = d.Set("account_id", connectorConfig.AccountID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("resource_directory_id", connectorConfig.ResourceDirectoryID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("access_key_id", connectorConfig.AccessKeyID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("excluded_accounts", utils.ConvertSliceToGenericArray(connectorConfig.ExcludedAccounts))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("disk_analyzer_inflight_disabled", connectorConfig.DiskAnalyzerInFlightDisabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizConnectorAlibabaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAlibabaUpdate called...")
+
+	var extraConfig json.RawMessage
+	if d.HasChange("extra_config") {
+		extraConfig = json.RawMessage(d.Get("extra_config").(string))
+	}
+
+	diags = updateConnectorFromParams(ctx, d, m, extraConfig)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorAlibabaRead(ctx, d, m)
+}
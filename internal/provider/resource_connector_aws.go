@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +19,19 @@ import (
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
 
+// awsConnectorExtraConfigDefaults maps the structured extra configuration attributes to the values they reset to when removed
+var awsConnectorExtraConfigDefaults = map[string]interface{}{
+	"opted_in_regions":                     []interface{}{},
+	"excluded_accounts":                    []interface{}{},
+	"excluded_ous":                         []interface{}{},
+	"skip_organization_scan":               false,
+	"audit_log_monitor_enabled":            false,
+	"disk_analyzer_inflight_disabled":      false,
+	"events_cloudtrail_bucket_name":        "",
+	"events_cloudtrail_bucket_sub_account": "",
+	"events_cloudtrail_organization":       "",
+}
+
 func resourceWizConnectorAws() *schema.Resource {
 	return &schema.Resource{
 		Description: "Connectors are used to connect AWS resources to Wiz.",
@@ -37,6 +52,50 @@ func resourceWizConnectorAws() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"wait_for_status": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Wait after creating or updating an enabled connector until it reaches one of these statuses, bounded by the `create` and `update` timeouts. A connector entering the `ERROR` status fails the apply, and a connector settling in another status outside this list ends the wait with a warning. When not set, the apply does not wait.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						connectorWaitStatus,
+					),
+				),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.StringInSlice(
+							connectorWaitStatus,
+							false,
+						),
+					),
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf(
+					"The connector status.\n    - Possible values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ConnectorStatus,
+					),
+				),
+			},
+			"error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf(
+					"The error code reported when the connector is in the `ERROR` status.\n    - Possible values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ConnectorErrorCode,
+					),
+				),
+			},
+			"cloud_account_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of cloud accounts discovered by the connector.",
+				Computed:    true,
+			},
 			"customer_role_arn": {
 				Type:        schema.TypeString,
 				Description: "The AWS customer role arn for Wiz to assume.",
@@ -44,34 +103,54 @@ func resourceWizConnectorAws() *schema.Resource {
 			},
 			"excluded_accounts": {
 				Type:        schema.TypeList,
-				Description: "The AWS accounts excluded from the connector.",
+				Description: "The AWS accounts excluded from the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"excluded_ous": {
 				Type:        schema.TypeList,
-				Description: "The AWS OUs excluded from the connector.",
+				Description: "The AWS OUs excluded from the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"audit_log_monitor_enabled": {
 				Type:        schema.TypeBool,
-				Description: "Whether audit log monitor is enabled. Note an advanced license is required.",
+				Description: "Whether audit log monitor is enabled. Note an advanced license is required. Conflicts with `extra_config`, removing the attribute resets it to `false`.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 			},
 			"disk_analyzer_inflight_disabled": {
 				Type:        schema.TypeBool,
-				Description: "If using Outpost, whether disk analyzer inflight scanning is disabled.",
+				Description: "If using Outpost, whether disk analyzer inflight scanning is disabled. Conflicts with `extra_config`, removing the attribute resets it to `false`.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 			},
 			"skip_organization_scan": {
 				Type:        schema.TypeBool,
-				Description: "Whether to skip the organization scan (account-scoped only).",
+				Description: "Whether to skip the organization scan (account-scoped only). Conflicts with `extra_config`, removing the attribute resets it to `false`.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 			},
 			"external_id_nonce": {
 				Type:        schema.TypeString,
@@ -80,8 +159,12 @@ func resourceWizConnectorAws() *schema.Resource {
 			},
 			"opted_in_regions": {
 				Type:        schema.TypeList,
-				Description: "The AWS regions opted in for the connector.",
+				Description: "The AWS regions opted in for the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -93,50 +176,128 @@ func resourceWizConnectorAws() *schema.Resource {
 			},
 			"events_cloudtrail_bucket_name": {
 				Type:        schema.TypeString,
-				Description: "If using Wiz Cloud Events, the CloudTrail bucket name.",
+				Description: "If using Wiz Cloud Events, the CloudTrail bucket name. Conflicts with `extra_config`, removing the attribute resets it to an empty string.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 			},
 			"events_cloudtrail_bucket_sub_account": {
 				Type:        schema.TypeString,
-				Description: "If using Wiz Cloud Events and CloudTrail is organizational, the CloudTrail bucket sub account.",
+				Description: "If using Wiz Cloud Events and CloudTrail is organizational, the CloudTrail bucket sub account. Conflicts with `extra_config`, removing the attribute resets it to an empty string.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 			},
 			"events_cloudtrail_organization": {
 				Type:        schema.TypeString,
-				Description: "If using Wiz Cloud Events and CloudTrail is deployed to AWS organizations, the organizational ID.",
+				Description: "If using Wiz Cloud Events and CloudTrail is deployed to AWS organizations, the organizational ID. Conflicts with `extra_config`, removing the attribute resets it to an empty string.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 			},
 			"auth_params": {
 				Type:        schema.TypeString,
-				Description: "The authentication parameters. Must be represented in `JSON` format.",
-				Required:    true,
+				Description: "The authentication parameters. Must be represented in `JSON` format. Changing the authentication parameters forces a new resource, moving them to `customer_role` and `outpost` unchanged does not. Prefer `customer_role` and `outpost` for a readable diff.",
+				Optional:    true,
 				Sensitive:   true,
+				ExactlyOneOf: []string{
+					"auth_params",
+					"customer_role",
+				},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.StringIsJSON,
 				),
 			},
+			"customer_role": {
+				Type:        schema.TypeList,
+				Description: "Authenticate with an IAM role in the customer account that Wiz assumes. Changing the role forces a new resource.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:        schema.TypeString,
+							Description: "The ARN of the customer role for Wiz to assume.",
+							Required:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringIsNotEmpty,
+							),
+						},
+					},
+				},
+			},
+			"outpost": {
+				Type:        schema.TypeList,
+				Description: "Scan the connected accounts with a Wiz Outpost. Requires `customer_role`. Changing the outpost or its scanner forces a new resource.",
+				Optional:    true,
+				MaxItems:    1,
+				RequiredWith: []string{
+					"customer_role",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"outpost_id": {
+							Type:        schema.TypeString,
+							Description: "The Wiz identifier of the outpost. A connector cannot move between outposts, so changing this forces a new resource.",
+							Required:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.IsUUID,
+							),
+						},
+						"scanner": {
+							Type:        schema.TypeList,
+							Description: "The role the outpost scanner assumes in the connected accounts.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_arn": {
+										Type:        schema.TypeString,
+										Description: "The ARN of the role for the outpost scanner to assume.",
+										Required:    true,
+										ValidateDiagFunc: validation.ToDiagFunc(
+											validation.StringIsNotEmpty,
+										),
+									},
+									"external_id": {
+										Type:        schema.TypeString,
+										Description: "The external ID required by the trust policy of the scanner role.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"configured_extra_config_attributes": connectorConfiguredExtraConfigAttributesSchema(),
 			"extra_config": {
 				// these are JSON fields; the schema does not support overrides, once a field is set, future changes require it to be passed
 				Type:        schema.TypeString,
-				Description: "Extra configuration for the connector. Must be represented in `JSON` format.",
+				Description: "Extra configuration for the connector. Must be represented in `JSON` format. Once a field is set, future changes require it to be passed, prefer the structured attributes which detect drift and reset removed settings.",
 				Optional:    true,
+				Computed:    true,
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.StringIsJSON,
 				),
 			},
 		},
-		// auth_params requires a resource recreation as they cannot be updated.
-		// to accommodate for importing resources into state, we can't use `ForceNew` in the schema definition.
-		// we use a customdiff and `ForceNewIfChange` for below attributes for only a change condition.
+		// the authentication parameters require a resource recreation as they cannot be updated.
+		// auth_params and the customer_role and outpost blocks are compared once expanded so moving between them does not recreate the connector.
 		CustomizeDiff: customdiff.All(
-			customdiff.ForceNewIfChange("auth_params", func(ctx context.Context, old, new, meta any) bool {
-				if old.(string) != "" {
-					return old.(string) != new.(string)
-				}
-				return false
-			},
-			),
+			connectorExtraConfigCustomizeDiff(awsConnectorExtraConfigDefaults),
+			connectorAwsAuthCustomizeDiff,
 		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		CreateContext: resourceWizConnectorAwsCreate,
 		ReadContext:   resourceWizConnectorAwsRead,
 		UpdateContext: resourceWizConnectorAwsUpdate,
@@ -165,8 +326,20 @@ func resourceWizConnectorAwsCreate(ctx context.Context, d *schema.ResourceData,
 	vars.Type = "aws"
 	vars.Enabled = &enabled
 
-	vars.AuthParams = json.RawMessage(d.Get("auth_params").(string))
-	vars.ExtraConfig = json.RawMessage(d.Get("extra_config").(string))
+	authParams, err := expandConnectorAwsAuthParams(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	vars.AuthParams = authParams
+	if connectorExtraConfigStructured(d) {
+		extraConfig, err := expandConnectorAwsExtraConfig(d)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		vars.ExtraConfig = extraConfig
+	} else {
+		vars.ExtraConfig = json.RawMessage(d.Get("extra_config").(string))
+	}
 
 	// process the request
 	data := &CreateConnector{}
@@ -179,7 +352,9 @@ func resourceWizConnectorAwsCreate(ctx context.Context, d *schema.ResourceData,
 	// set the id
 	d.SetId(data.CreateConnector.Connector.ID)
 
-	return resourceWizConnectorAwsRead(ctx, d, m)
+	waitDiags := waitForConnectorStatus(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+
+	return appendConnectorReadDiags(waitDiags, resourceWizConnectorAwsRead(ctx, d, m))
 }
 
 func resourceWizConnectorAwsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -196,6 +371,9 @@ func resourceWizConnectorAwsRead(ctx context.Context, d *schema.ResourceData, m
 	      id
 	      name
 	      enabled
+	      status
+	      errorCode
+	      cloudAccountCount
 	      authParams
 	      extraConfig
 	      config {
@@ -207,6 +385,12 @@ func resourceWizConnectorAwsRead(ctx context.Context, d *schema.ResourceData, m
 	          externalIdNonce
 	          optedInRegions
 	          customerRoleARN
+	          diskAnalyzer {
+	            scanner {
+	              roleARN
+	              externalId
+	            }
+	          }
 	          auditLogMonitorEnabled
 	          skipOrganizationScan
 	          cloudTrailConfig {
@@ -264,6 +448,18 @@ func resourceWizConnectorAwsRead(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	err = d.Set("status", data.Connector.Status)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("error_code", data.Connector.ErrorCode)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("cloud_account_count", data.Connector.CloudAccountCount)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
 
 	var mapExtraConfig map[string]interface{}
 	err = json.Unmarshal(data.Connector.ExtraConfig, &mapExtraConfig)
@@ -343,6 +539,16 @@ func resourceWizConnectorAwsRead(ctx context.Context, d *schema.ResourceData, m
 		return append(diags, diag.FromErr(err)...)
 	}
 
+	diags = append(diags, flattenConnectorAwsAuth(d, data.Connector.AuthParams, connectorConfig)...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// surface a failed connection without failing the refresh
+	if data.Connector.Status == connectorStatusError {
+		diags = append(diags, connectorErrorDiagnostic(diag.Warning, data.Connector))
+	}
+
 	return diags
 }
 
@@ -377,7 +583,15 @@ func resourceWizConnectorAwsUpdate(ctx context.Context, d *schema.ResourceData,
 		enabled := d.Get("enabled").(bool)
 		vars.Patch.Enabled = &enabled
 	}
-	if d.HasChange("extra_config") {
+	if connectorExtraConfigStructured(d) {
+		if d.HasChanges(connectorExtraConfigAttributes(awsConnectorExtraConfigDefaults)...) {
+			extraConfig, err := expandConnectorAwsExtraConfig(d)
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+			vars.Patch.ExtraConfig = extraConfig
+		}
+	} else if d.HasChange("extra_config") {
 		vars.Patch.ExtraConfig = json.RawMessage(d.Get("extra_config").(string))
 	}
 
@@ -389,7 +603,13 @@ func resourceWizConnectorAwsUpdate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	return resourceWizConnectorAwsRead(ctx, d, m)
+	// only wait when the change can affect the connection
+	var waitDiags diag.Diagnostics
+	if d.HasChanges("enabled", "extra_config") {
+		waitDiags = waitForConnectorStatus(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+	}
+
+	return appendConnectorReadDiags(waitDiags, resourceWizConnectorAwsRead(ctx, d, m))
 }
 
 func resourceWizConnectorAwsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -421,3 +641,204 @@ func resourceWizConnectorAwsDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// expandConnectorAwsAuthParams returns `auth_params` when set, otherwise builds the authentication parameters from `customer_role` and `outpost`
+func expandConnectorAwsAuthParams(d *schema.ResourceData) (json.RawMessage, error) {
+	return buildConnectorAwsAuthParams(
+		d.Get("auth_params").(string),
+		d.Get("customer_role").([]interface{}),
+		d.Get("outpost").([]interface{}),
+	)
+}
+
+// buildConnectorAwsAuthParams returns the raw authentication parameters when set, otherwise builds them from the customer role and outpost blocks
+func buildConnectorAwsAuthParams(rawAuthParams string, customerRole []interface{}, outpost []interface{}) (json.RawMessage, error) {
+	if rawAuthParams != "" {
+		return json.RawMessage(rawAuthParams), nil
+	}
+
+	authParams := &wiz.ConnectorAuthParamsAWS{}
+	if len(customerRole) > 0 {
+		if role, ok := customerRole[0].(map[string]interface{}); ok {
+			authParams.CustomerRoleARN = role["role_arn"].(string)
+		}
+	}
+
+	if len(outpost) > 0 {
+		if outpostConfig, ok := outpost[0].(map[string]interface{}); ok {
+			authParams.OutpostID = outpostConfig["outpost_id"].(string)
+			scanner := outpostConfig["scanner"].([]interface{})
+			if len(scanner) > 0 {
+				if scannerConfig, ok := scanner[0].(map[string]interface{}); ok {
+					authParams.DiskAnalyzer = &wiz.ConnectorAuthConfigAWSOutpost{
+						Scanner: wiz.ConnectorAuthConfigAWSOutpostScanner{
+							RoleARN:    scannerConfig["role_arn"].(string),
+							ExternalID: scannerConfig["external_id"].(string),
+						},
+					}
+				}
+			}
+		}
+	}
+
+	return json.Marshal(authParams)
+}
+
+// connectorAwsAuthParamsMap builds the authentication parameters and decodes them for comparison
+func connectorAwsAuthParamsMap(rawAuthParams string, customerRole []interface{}, outpost []interface{}) (map[string]interface{}, error) {
+	authParams, err := buildConnectorAwsAuthParams(rawAuthParams, customerRole, outpost)
+	if err != nil {
+		return nil, err
+	}
+
+	var mapAuthParams map[string]interface{}
+	err = json.Unmarshal(authParams, &mapAuthParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAuthParams, nil
+}
+
+// connectorAwsAuthCustomizeDiff forces a new resource when the authentication parameters sent to Wiz change.
+// imported connectors have no authentication in state, so only the customer role ARN returned by Wiz is compared for them.
+func connectorAwsAuthCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	attributes := []string{"auth_params", "customer_role", "outpost"}
+	if d.Id() == "" || !d.HasChanges(attributes...) {
+		return nil
+	}
+
+	var changed string
+	for _, attribute := range attributes {
+		if d.HasChange(attribute) {
+			changed = attribute
+			break
+		}
+	}
+
+	// unknown values can't be compared, assume the authentication changes
+	for _, key := range []string{
+		"auth_params",
+		"customer_role.0.role_arn",
+		"outpost.0.outpost_id",
+		"outpost.0.scanner.0.role_arn",
+		"outpost.0.scanner.0.external_id",
+	} {
+		if !d.NewValueKnown(key) {
+			return d.ForceNew(changed)
+		}
+	}
+
+	oldParams, newParams := d.GetChange("auth_params")
+	oldRole, newRole := d.GetChange("customer_role")
+	oldOutpost, newOutpost := d.GetChange("outpost")
+
+	newAuth, err := connectorAwsAuthParamsMap(newParams.(string), newRole.([]interface{}), newOutpost.([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if oldParams.(string) == "" && len(oldRole.([]interface{})) == 0 {
+		customerRoleARN := d.Get("customer_role_arn").(string)
+		if customerRoleARN != "" && newAuth["customerRoleARN"] != customerRoleARN {
+			tflog.Debug(ctx, "customer role changed")
+			return d.ForceNew(changed)
+		}
+		return nil
+	}
+
+	oldAuth, err := connectorAwsAuthParamsMap(oldParams.(string), oldRole.([]interface{}), oldOutpost.([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(oldAuth, newAuth) {
+		tflog.Debug(ctx, "authentication parameters changed")
+		return d.ForceNew(changed)
+	}
+
+	return nil
+}
+
+// flattenConnectorAwsAuth refreshes `customer_role` and `outpost` from the connector, values Wiz does not return keep their state
+func flattenConnectorAwsAuth(d *schema.ResourceData, rawAuthParams json.RawMessage, connectorConfig wiz.ConnectorConfigAWS) (diags diag.Diagnostics) {
+	// the structured blocks are only refreshed when in use, connectors managed with auth_params are left untouched
+	if len(d.Get("customer_role").([]interface{})) == 0 {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsAWS
+	if len(rawAuthParams) > 0 {
+		err := json.Unmarshal(rawAuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsAWS: %v", err)...)
+		}
+	}
+
+	roleARN := connectorConfig.CustomerRoleARN
+	if roleARN == "" {
+		roleARN = d.Get("customer_role.0.role_arn").(string)
+	}
+	err := d.Set("customer_role", []interface{}{
+		map[string]interface{}{
+			"role_arn": roleARN,
+		},
+	})
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if len(d.Get("outpost").([]interface{})) == 0 {
+		return diags
+	}
+
+	outpostID := authParams.OutpostID
+	if outpostID == "" {
+		outpostID = d.Get("outpost.0.outpost_id").(string)
+	}
+
+	var scanner []interface{}
+	if connectorConfig.DiskAnalyzer.Scanner.RoleARN != "" {
+		scanner = append(scanner, map[string]interface{}{
+			"role_arn":    connectorConfig.DiskAnalyzer.Scanner.RoleARN,
+			"external_id": connectorConfig.DiskAnalyzer.Scanner.ExternalID,
+		})
+	} else {
+		scanner = d.Get("outpost.0.scanner").([]interface{})
+	}
+
+	err = d.Set("outpost", []interface{}{
+		map[string]interface{}{
+			"outpost_id": outpostID,
+			"scanner":    scanner,
+		},
+	})
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// expandConnectorAwsExtraConfig builds the extra configuration from the structured attributes, unset attributes are sent with their defaults to reset them
+func expandConnectorAwsExtraConfig(d *schema.ResourceData) (json.RawMessage, error) {
+	extraConfig := &wiz.ConnectorExtraConfigAWS{
+		OptedInRegions:               utils.ConvertListToString(d.Get("opted_in_regions").([]interface{})),
+		ExcludedAccounts:             utils.ConvertListToString(d.Get("excluded_accounts").([]interface{})),
+		ExcludedOUs:                  utils.ConvertListToString(d.Get("excluded_ous").([]interface{})),
+		SkipOrganizationScan:         d.Get("skip_organization_scan").(bool),
+		AuditLogMonitorEnabled:       d.Get("audit_log_monitor_enabled").(bool),
+		DiskAnalyzerInFlightDisabled: d.Get("disk_analyzer_inflight_disabled").(bool),
+	}
+
+	cloudTrailConfig := wiz.ConnectorConfigAWSCloudTrail{
+		BucketName:       d.Get("events_cloudtrail_bucket_name").(string),
+		BucketSubAccount: d.Get("events_cloudtrail_bucket_sub_account").(string),
+		TrailOrg:         d.Get("events_cloudtrail_organization").(string),
+	}
+	if cloudTrailConfig != (wiz.ConnectorConfigAWSCloudTrail{}) {
+		extraConfig.CloudTrailConfig = &cloudTrailConfig
+	}
+
+	return json.Marshal(extraConfig)
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandConnectorAwsAuthParams(t *testing.T) {
+	expected := `{"customerRoleARN":"arn:aws:iam::100000000009:role/wiz-customer","outpostId":"078862d0-a62f-406c-b966-13445af34c0d","diskAnalyzer":{"scanner":{"roleARN":"arn:aws:iam::100000000009:role/outpost-scanner"}}}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorAws().Schema,
+		map[string]interface{}{
+			"name": "example",
+			"customer_role": []interface{}{
+				map[string]interface{}{
+					"role_arn": "arn:aws:iam::100000000009:role/wiz-customer",
+				},
+			},
+			"outpost": []interface{}{
+				map[string]interface{}{
+					"outpost_id": "078862d0-a62f-406c-b966-13445af34c0d",
+					"scanner": []interface{}{
+						map[string]interface{}{
+							"role_arn": "arn:aws:iam::100000000009:role/outpost-scanner",
+						},
+					},
+				},
+			},
+		},
+	)
+
+	authParams, err := expandConnectorAwsAuthParams(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(authParams) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			authParams,
+			expected,
+		)
+	}
+
+	expected = `{"customerRoleARN":"arn:aws:iam::100000000009:role/wiz-customer"}`
+
+	d = schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorAws().Schema,
+		map[string]interface{}{
+			"name":        "example",
+			"auth_params": expected,
+		},
+	)
+
+	authParams, err = expandConnectorAwsAuthParams(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(authParams) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			authParams,
+			expected,
+		)
+	}
+}
+
+func TestConnectorAwsAuthParamsMap(t *testing.T) {
+	// raw authentication parameters moved to the structured blocks compare equal
+	rawAuth, err := connectorAwsAuthParamsMap(
+		`{ "outpostId": "078862d0-a62f-406c-b966-13445af34c0d", "customerRoleARN": "arn:aws:iam::100000000009:role/wiz-customer" }`,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	structuredAuth, err := connectorAwsAuthParamsMap(
+		"",
+		[]interface{}{
+			map[string]interface{}{
+				"role_arn": "arn:aws:iam::100000000009:role/wiz-customer",
+			},
+		},
+		[]interface{}{
+			map[string]interface{}{
+				"outpost_id": "078862d0-a62f-406c-b966-13445af34c0d",
+				"scanner":    []interface{}{},
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(rawAuth, structuredAuth) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			structuredAuth,
+			rawAuth,
+		)
+	}
+}
+
+func TestExpandConnectorAwsExtraConfig(t *testing.T) {
+	expected := `{"optedInRegions":["us-east-1"],"excludedAccounts":[],"excludedOUs":["ou-abcd-12345678"],"skipOrganizationScan":false,"auditLogMonitorEnabled":true,"diskAnalyzerInFlightDisabled":false,"cloudTrailConfig":null}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorAws().Schema,
+		map[string]interface{}{
+			"name":                      "example",
+			"opted_in_regions":          []interface{}{"us-east-1"},
+			"excluded_ous":              []interface{}{"ou-abcd-12345678"},
+			"audit_log_monitor_enabled": true,
+		},
+	)
+
+	extraConfig, err := expandConnectorAwsExtraConfig(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(extraConfig) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			extraConfig,
+			expected,
+		)
+	}
+}
@@ -0,0 +1,430 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// azureConnectorScopeAttributes maps the scope attributes to their authentication parameter, in the order they are compared
+var azureConnectorScopeAttributes = []struct {
+	attribute string
+	key       string
+}{
+	{"tenant_id", "tenantId"},
+	{"management_group_id", "managementGroupId"},
+	{"subscription_id", "subscriptionId"},
+}
+
+// azureConnectorExtraConfigDefaults maps the structured extra configuration attributes to the values they reset to when removed
+var azureConnectorExtraConfigDefaults = map[string]interface{}{
+	"included_subscriptions":          []interface{}{},
+	"excluded_subscriptions":          []interface{}{},
+	"excluded_management_groups":      []interface{}{},
+	"audit_log_monitor_enabled":       false,
+	"disk_analyzer_inflight_disabled": false,
+}
+
+// azureConnectorConfigFragment selects the Azure fields of the connector config union
+const azureConnectorConfigFragment = `
+	      config {
+	        ... on ConnectorConfigAzure {
+	          tenantId
+	          environment
+	          managementGroupId
+	          subscriptionId
+	          isManagedIdentity
+	          includedSubscriptions
+	          excludedSubscriptions
+	          excludedManagementGroups
+	          auditLogMonitorEnabled
+	          diskAnalyzerInFlightDisabled
+	          snapshotsResourceGroupId
+	        }
+	      }`
+
+func resourceWizConnectorAzure() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect Azure resources to Wiz.",
+		Schema: connectorSchema(map[string]*schema.Schema{
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Description: "The Azure tenant ID. Sent as the `tenantId` authentication parameter, takes precedence over `auth_params`. Changing it recreates the connector.",
+				Optional:    true,
+				Computed:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Description: "The Azure cloud environment, e.g. `AzureCloud`.",
+				Computed:    true,
+			},
+			"management_group_id": {
+				Type:        schema.TypeString,
+				Description: "The Azure management group ID, when the connector is scoped to a management group. Sent as the `managementGroupId` authentication parameter, takes precedence over `auth_params`. Changing it recreates the connector.",
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					"subscription_id",
+				},
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Description: "The Azure subscription ID, when the connector is scoped to a single subscription. Sent as the `subscriptionId` authentication parameter, takes precedence over `auth_params`. Changing it recreates the connector.",
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					"management_group_id",
+				},
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"is_managed_identity": {
+				Type:        schema.TypeBool,
+				Description: "Whether the connector authenticates with the Wiz managed identity (app registration consent) rather than a customer-provided service principal.",
+				Computed:    true,
+			},
+			"included_subscriptions": {
+				Type:        schema.TypeList,
+				Description: "The Azure subscriptions included by the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"excluded_subscriptions": {
+				Type:        schema.TypeList,
+				Description: "The Azure subscriptions excluded by the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"excluded_management_groups": {
+				Type:        schema.TypeList,
+				Description: "The Azure management groups excluded by the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"audit_log_monitor_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether audit log monitor is enabled. Note an advanced license is required. Conflicts with `extra_config`, removing the attribute resets it to `false`.",
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
+			},
+			"disk_analyzer_inflight_disabled": {
+				Type:        schema.TypeBool,
+				Description: "If using Outpost, whether disk analyzer inflight scanning is disabled. Conflicts with `extra_config`, removing the attribute resets it to `false`.",
+				Optional:    true,
+				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
+			},
+			"snapshots_resource_group_id": {
+				Type:        schema.TypeString,
+				Description: "If using Outpost, the resource group in which disk snapshots are created.",
+				Computed:    true,
+			},
+			"auth_params": {
+				Type:        schema.TypeString,
+				Description: "The authentication parameters. Must be represented in `JSON` format. Set `isManagedIdentity`, and when using a service principal or outposts, also include the credentials, `outPostId` and the `diskAnalyzer` structure. The scope keys `tenantId`, `managementGroupId` and `subscriptionId` are set by the scope attributes when configured.",
+				Optional:    true,
+				Sensitive:   true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+			"configured_extra_config_attributes": connectorConfiguredExtraConfigAttributesSchema(),
+			"extra_config": {
+				// these are JSON fields; the schema does not support overrides, once a field is set, future changes require it to be passed
+				Type:        schema.TypeString,
+				Description: "Extra configuration for the connector. Must be represented in `JSON` format. Once a field is set, future changes require it to be passed, prefer the structured attributes which detect drift and reset removed settings.",
+				Optional:    true,
+				Computed:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+		}),
+		// the authentication parameters require a resource recreation as they cannot be updated.
+		// the scope attributes and auth_params are compared once merged so moving a key between them does not recreate the connector.
+		CustomizeDiff: customdiff.All(
+			connectorExtraConfigCustomizeDiff(azureConnectorExtraConfigDefaults),
+			azureConnectorAuthCustomizeDiff,
+		),
+		CreateContext: resourceWizConnectorAzureCreate,
+		ReadContext:   resourceWizConnectorAzureRead,
+		UpdateContext: resourceWizConnectorAzureUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// expandConnectorAzureAuthParams merges the scope attributes into the authentication parameters, the scope attributes take precedence
+func expandConnectorAzureAuthParams(authParams string, scope map[string]string) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	if authParams != "" {
+		err := json.Unmarshal([]byte(authParams), &params)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, scopeAttribute := range azureConnectorScopeAttributes {
+		if scope[scopeAttribute.attribute] != "" {
+			params[scopeAttribute.key] = scope[scopeAttribute.attribute]
+		}
+	}
+
+	return params, nil
+}
+
+// azureConnectorAuthCustomizeDiff forces a new resource when the authentication parameters sent to Wiz change.
+// imported connectors have no auth_params in state, so only the configured scope is compared to the scope returned by Wiz.
+func azureConnectorAuthCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	attributes := []string{"auth_params"}
+	for _, scopeAttribute := range azureConnectorScopeAttributes {
+		attributes = append(attributes, scopeAttribute.attribute)
+	}
+	if d.Id() == "" || !d.HasChanges(attributes...) {
+		return nil
+	}
+
+	// unknown values can't be compared, assume the authentication changes
+	for _, attribute := range attributes {
+		if !d.NewValueKnown(attribute) {
+			return d.ForceNew(attribute)
+		}
+	}
+
+	oldParams, newParams := d.GetChange("auth_params")
+	oldScope := make(map[string]string)
+	newScope := make(map[string]string)
+	rawConfig := d.GetRawConfig()
+	for _, scopeAttribute := range azureConnectorScopeAttributes {
+		oldValue, newValue := d.GetChange(scopeAttribute.attribute)
+		oldScope[scopeAttribute.attribute] = oldValue.(string)
+		// computed values kept from state are not sent
+		if !rawConfig.GetAttr(scopeAttribute.attribute).IsNull() {
+			newScope[scopeAttribute.attribute] = newValue.(string)
+		}
+	}
+
+	oldAuth, err := expandConnectorAzureAuthParams(oldParams.(string), oldScope)
+	if err != nil {
+		return err
+	}
+	newAuth, err := expandConnectorAzureAuthParams(newParams.(string), newScope)
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	if oldParams.(string) == "" {
+		for _, scopeAttribute := range azureConnectorScopeAttributes {
+			if _, ok := newAuth[scopeAttribute.key]; ok && oldScope[scopeAttribute.attribute] != "" {
+				keys = append(keys, scopeAttribute.key)
+			}
+		}
+	} else {
+		oldConfigured, err := expandConnectorAzureAuthParams(oldParams.(string), nil)
+		if err != nil {
+			return err
+		}
+		for key := range oldConfigured {
+			keys = append(keys, key)
+		}
+		for key := range newAuth {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		if reflect.DeepEqual(oldAuth[key], newAuth[key]) {
+			continue
+		}
+		tflog.Debug(ctx, fmt.Sprintf("authentication parameter %s changed", key))
+		for _, attribute := range attributes {
+			if d.HasChange(attribute) {
+				return d.ForceNew(attribute)
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandConnectorAzureExtraConfig builds the extra configuration from the structured attributes, unset attributes are sent with their defaults to reset them
+func expandConnectorAzureExtraConfig(d *schema.ResourceData) (json.RawMessage, error) {
+	extraConfig := &wiz.ConnectorExtraConfigAzure{
+		IncludedSubscriptions:        utils.ConvertListToString(d.Get("included_subscriptions").([]interface{})),
+		ExcludedSubscriptions:        utils.ConvertListToString(d.Get("excluded_subscriptions").([]interface{})),
+		ExcludedManagementGroups:     utils.ConvertListToString(d.Get("excluded_management_groups").([]interface{})),
+		AuditLogMonitorEnabled:       d.Get("audit_log_monitor_enabled").(bool),
+		DiskAnalyzerInFlightDisabled: d.Get("disk_analyzer_inflight_disabled").(bool),
+	}
+
+	return json.Marshal(extraConfig)
+}
+
+func resourceWizConnectorAzureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAzureCreate called...")
+
+	scope := make(map[string]string)
+	for _, scopeAttribute := range azureConnectorScopeAttributes {
+		scope[scopeAttribute.attribute] = d.Get(scopeAttribute.attribute).(string)
+	}
+	authParams, err := expandConnectorAzureAuthParams(d.Get("auth_params").(string), scope)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if _, ok := authParams["tenantId"]; !ok {
+		return append(diags, diag.Errorf("one of tenant_id or the tenantId key of auth_params must be set")...)
+	}
+
+	var extraConfig json.RawMessage
+	if connectorExtraConfigStructured(d) {
+		extraConfig, err = expandConnectorAzureExtraConfig(d)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	} else {
+		extraConfig = json.RawMessage(d.Get("extra_config").(string))
+	}
+
+	diags = createConnectorFromParams(ctx, d, m, "azure", authParams, extraConfig)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorAzureRead(ctx, d, m)
+}
+
+func resourceWizConnectorAzureRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAzureRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readConnectorFromParams(ctx, d, m, azureConnectorConfigFragment)
+	if data == nil {
+		return diags
+	}
+
+	diags = append(diags, setConnectorExtraConfig(d, data.Connector.ExtraConfig)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var connectorConfig wiz.ConnectorConfigAzure
+	connectorConfigBytes, err := json.Marshal(data.Connector.Config)
+	if err != nil {
+		return append(diags, diag.Errorf("unable to marshal ConnectorConfigAzure: %v", err)...)
+	}
+	if err := json.Unmarshal(connectorConfigBytes, &connectorConfig); err != nil {
+		return append(diags, diag.Errorf("unable to unmarshal ConnectorConfigAzure: %v", err)...)
+	}
+
+	diags = append(diags, setConnectorAuthAttributes(d, map[string]string{
+		"tenant_id":           connectorConfig.TenantID,
+		"management_group_id": connectorConfig.ManagementGroupID,
+		"subscription_id":     connectorConfig.SubscriptionID,
+	})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	err = d.Set("environment", connectorConfig.Environment)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("is_managed_identity", connectorConfig.IsManagedIdentity)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("included_subscriptions", utils.ConvertSliceToGenericArray(connectorConfig.IncludedSubscriptions))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("excluded_subscriptions", utils.ConvertSliceToGenericArray(connectorConfig.ExcludedSubscriptions))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("excluded_management_groups", utils.ConvertSliceToGenericArray(connectorConfig.ExcludedManagementGroups))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("audit_log_monitor_enabled", connectorConfig.AuditLogMonitorEnabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("disk_analyzer_inflight_disabled", connectorConfig.DiskAnalyzerInFlightDisabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("snapshots_resource_group_id", connectorConfig.SnapshotsResourceGroupID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizConnectorAzureUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAzureUpdate called...")
+
+	var extraConfig json.RawMessage
+	if connectorExtraConfigStructured(d) {
+		if d.HasChanges(connectorExtraConfigAttributes(azureConnectorExtraConfigDefaults)...) {
+			var err error
+			extraConfig, err = expandConnectorAzureExtraConfig(d)
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+	} else if d.HasChange("extra_config") {
+		extraConfig = json.RawMessage(d.Get("extra_config").(string))
+	}
+
+	diags = updateConnectorFromParams(ctx, d, m, extraConfig)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorAzureRead(ctx, d, m)
+}
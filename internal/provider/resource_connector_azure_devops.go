@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorAzureDevOps() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect Azure DevOps organizations to Wiz for code scanning.",
+		Schema: vcsConnectorSchema("Azure DevOps", map[string]*schema.Schema{
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The Azure DevOps URL, set to the collection URL when connecting Azure DevOps Server.",
+				Optional:    true,
+				Default:     "https://dev.azure.com",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsURLWithHTTPS,
+				),
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Description: "The Azure DevOps organization to connect.",
+				Required:    true,
+			},
+			"personal_access_token": {
+				Type:        schema.TypeString,
+				Description: "An Azure DevOps personal access token with the `Code (Read)` and `Project and Team (Read)` scopes.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		}),
+		CustomizeDiff: vcsConnectorCustomizeDiff(
+			"url",
+			"organization",
+			"personal_access_token",
+		),
+		CreateContext: resourceWizConnectorAzureDevOpsCreate,
+		ReadContext:   resourceWizConnectorAzureDevOpsRead,
+		UpdateContext: resourceWizConnectorAzureDevOpsUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorAzureDevOpsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAzureDevOpsCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsAzureDevOps{
+		URL:          d.Get("url").(string),
+		Organization: d.Get("organization").(string),
+		Token:        d.Get("personal_access_token").(string),
+	}
+
+	diags = createVCSConnector(ctx, d, m, "azure_devops", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorAzureDevOpsRead(ctx, d, m)
+}
+
+func resourceWizConnectorAzureDevOpsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAzureDevOpsRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readVCSConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsAzureDevOps
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsAzureDevOps: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"url":          authParams.URL,
+		"organization": authParams.Organization,
+	})
+}
+
+func resourceWizConnectorAzureDevOpsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorAzureDevOpsUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateVCSConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorAzureDevOpsRead(ctx, d, m)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandConnectorAzureAuthParams(t *testing.T) {
+	expected := map[string]interface{}{
+		"isManagedIdentity": true,
+		"tenantId":          "8e4f5a2d-1b3c-4d6e-9f0a-7b8c9d0e1f2a",
+		"managementGroupId": "mg-root",
+	}
+
+	authParams, err := expandConnectorAzureAuthParams(
+		`{"isManagedIdentity":true,"tenantId":"00000000-0000-0000-0000-000000000000"}`,
+		map[string]string{
+			"tenant_id":           "8e4f5a2d-1b3c-4d6e-9f0a-7b8c9d0e1f2a",
+			"management_group_id": "mg-root",
+			"subscription_id":     "",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(authParams, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			authParams,
+			expected,
+		)
+	}
+}
+
+func TestExpandConnectorAzureExtraConfig(t *testing.T) {
+	expected := `{"includedSubscriptions":[],"excludedSubscriptions":["4b2a8c6d-0e1f-4a3b-8c5d-6e7f8a9b0c1d"],"excludedManagementGroups":[],"auditLogMonitorEnabled":true,"diskAnalyzerInFlightDisabled":false}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorAzure().Schema,
+		map[string]interface{}{
+			"name":                      "example",
+			"tenant_id":                 "8e4f5a2d-1b3c-4d6e-9f0a-7b8c9d0e1f2a",
+			"excluded_subscriptions":    []interface{}{"4b2a8c6d-0e1f-4a3b-8c5d-6e7f8a9b0c1d"},
+			"audit_log_monitor_enabled": true,
+		},
+	)
+
+	extraConfig, err := expandConnectorAzureExtraConfig(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(extraConfig) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			extraConfig,
+			expected,
+		)
+	}
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorBitbucket() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect Bitbucket workspaces to Wiz for code scanning.",
+		Schema: vcsConnectorSchema("Bitbucket", map[string]*schema.Schema{
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The Bitbucket URL, set to the server URL when connecting Bitbucket Data Center.",
+				Optional:    true,
+				Default:     "https://bitbucket.org",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsURLWithHTTPS,
+				),
+			},
+			"workspace": {
+				Type:        schema.TypeString,
+				Description: "The Bitbucket Cloud workspace, or the project key when connecting Bitbucket Data Center.",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The Bitbucket Cloud username that owns the app password.",
+				Optional:    true,
+				RequiredWith: []string{
+					"app_password",
+				},
+			},
+			"app_password": {
+				Type:        schema.TypeString,
+				Description: "A Bitbucket Cloud app password with read access to the workspace repositories.",
+				Optional:    true,
+				Sensitive:   true,
+				ExactlyOneOf: []string{
+					"app_password",
+					"access_token",
+				},
+				RequiredWith: []string{
+					"username",
+				},
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Description: "A Bitbucket workspace access token, or an HTTP access token when connecting Bitbucket Data Center.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		}),
+		CustomizeDiff: vcsConnectorCustomizeDiff(
+			"url",
+			"workspace",
+			"username",
+			"app_password",
+			"access_token",
+		),
+		CreateContext: resourceWizConnectorBitbucketCreate,
+		ReadContext:   resourceWizConnectorBitbucketRead,
+		UpdateContext: resourceWizConnectorBitbucketUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorBitbucketCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorBitbucketCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsBitbucket{
+		URL:         d.Get("url").(string),
+		Workspace:   d.Get("workspace").(string),
+		Username:    d.Get("username").(string),
+		AppPassword: d.Get("app_password").(string),
+		Token:       d.Get("access_token").(string),
+	}
+
+	diags = createVCSConnector(ctx, d, m, "bitbucket", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorBitbucketRead(ctx, d, m)
+}
+
+func resourceWizConnectorBitbucketRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorBitbucketRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readVCSConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsBitbucket
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsBitbucket: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"url":       authParams.URL,
+		"workspace": authParams.Workspace,
+		"username":  authParams.Username,
+	})
+}
+
+func resourceWizConnectorBitbucketUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorBitbucketUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateVCSConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorBitbucketRead(ctx, d, m)
+}
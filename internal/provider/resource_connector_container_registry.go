@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// containerRegistryConnectorSchema returns the attributes shared by the container registry connectors merged with the connector specific attributes
+func containerRegistryConnectorSchema(registryName string, attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	registrySchema := map[string]*schema.Schema{
+		"included_repositories": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("The %s repositories to scan, wildcards (`*`) are supported. When not set, all repositories are scanned.", registryName),
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"excluded_repositories": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("The %s repositories to exclude from scanning, wildcards (`*`) are supported.", registryName),
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"scan_frequency": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: fmt.Sprintf(
+				"How often the registry images are scanned. When not set, Wiz applies its default scan frequency.\n    - Allowed values: %s",
+				utils.SliceOfStringToMDUList(
+					wiz.ContainerRegistryScanFrequency,
+				),
+			),
+			ValidateDiagFunc: validation.ToDiagFunc(
+				validation.StringInSlice(
+					wiz.ContainerRegistryScanFrequency,
+					false,
+				),
+			),
+		},
+	}
+
+	for name, attribute := range attributes {
+		registrySchema[name] = attribute
+	}
+
+	return connectorSchema(registrySchema)
+}
+
+// containerRegistryConnectorCustomizeDiff forces a new resource when any of the authentication attributes change
+func containerRegistryConnectorCustomizeDiff(authAttributes ...string) schema.CustomizeDiffFunc {
+	return customdiff.All(connectorForceNewIfChange(authAttributes...)...)
+}
+
+// expandContainerRegistryConnectorExtraConfig builds the extra configuration shared by the container registry connectors
+func expandContainerRegistryConnectorExtraConfig(d *schema.ResourceData) (json.RawMessage, error) {
+	extraConfig := &wiz.ConnectorExtraConfigContainerRegistry{
+		IncludedRepositories: utils.ConvertListToString(d.Get("included_repositories").([]interface{})),
+		ExcludedRepositories: utils.ConvertListToString(d.Get("excluded_repositories").([]interface{})),
+		ScanFrequency:        d.Get("scan_frequency").(string),
+	}
+
+	return json.Marshal(extraConfig)
+}
+
+// flattenContainerRegistryConnectorExtraConfig sets the attributes derived from the extra configuration of a container registry connector
+func flattenContainerRegistryConnectorExtraConfig(d *schema.ResourceData, rawExtraConfig json.RawMessage) (diags diag.Diagnostics) {
+	var extraConfig wiz.ConnectorExtraConfigContainerRegistry
+	if len(rawExtraConfig) > 0 {
+		err := json.Unmarshal(rawExtraConfig, &extraConfig)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorExtraConfigContainerRegistry: %v", err)...)
+		}
+	}
+
+	err := d.Set("included_repositories", utils.ConvertSliceToGenericArray(extraConfig.IncludedRepositories))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("excluded_repositories", utils.ConvertSliceToGenericArray(extraConfig.ExcludedRepositories))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	// the scan frequency is only returned once it is set
+	if extraConfig.ScanFrequency != "" {
+		err = d.Set("scan_frequency", extraConfig.ScanFrequency)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// createContainerRegistryConnector creates a container registry connector and sets the resource id
+func createContainerRegistryConnector(ctx context.Context, d *schema.ResourceData, m interface{}, connectorType string, authParams interface{}) (diags diag.Diagnostics) {
+	extraConfig, err := expandContainerRegistryConnectorExtraConfig(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return createConnectorFromParams(ctx, d, m, connectorType, authParams, extraConfig)
+}
+
+// readContainerRegistryConnector reads a container registry connector and sets the shared attributes, a nil payload is returned if the connector no longer exists
+func readContainerRegistryConnector(ctx context.Context, d *schema.ResourceData, m interface{}) (*ReadConnectorPayload, diag.Diagnostics) {
+	data, diags := readConnectorFromParams(ctx, d, m, "")
+	if data == nil {
+		return nil, diags
+	}
+
+	diags = append(diags, flattenContainerRegistryConnectorExtraConfig(d, data.Connector.ExtraConfig)...)
+	if len(diags) > 0 {
+		return nil, diags
+	}
+
+	return data, diags
+}
+
+// updateContainerRegistryConnector updates the name, enabled state and extra configuration of a container registry connector
+func updateContainerRegistryConnector(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	var extraConfig json.RawMessage
+	if d.HasChanges("included_repositories", "excluded_repositories", "scan_frequency") {
+		var err error
+		extraConfig, err = expandContainerRegistryConnectorExtraConfig(d)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return updateConnectorFromParams(ctx, d, m, extraConfig)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandContainerRegistryConnectorExtraConfig(t *testing.T) {
+	expected := `{"excludedRepositories":["sandbox/*"]}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorJFrog().Schema,
+		map[string]interface{}{
+			"name":         "example",
+			"registry_url": "https://example.jfrog.io",
+			"excluded_repositories": []interface{}{
+				"sandbox/*",
+			},
+		},
+	)
+
+	extraConfig, err := expandContainerRegistryConnectorExtraConfig(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(extraConfig) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			extraConfig,
+			expected,
+		)
+	}
+}
+
+func TestFlattenContainerRegistryConnectorExtraConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorDockerHub().Schema,
+		map[string]interface{}{
+			"scan_frequency": "HOURLY",
+		},
+	)
+
+	// a missing scan frequency keeps the configured value
+	diags := flattenContainerRegistryConnectorExtraConfig(d, json.RawMessage(`{"includedRepositories":["example/api"]}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if frequency := d.Get("scan_frequency").(string); frequency != "HOURLY" {
+		t.Errorf("Expected scan_frequency HOURLY, got %s", frequency)
+	}
+	if included := d.Get("included_repositories").([]interface{}); len(included) != 1 || included[0] != "example/api" {
+		t.Errorf("Expected included repositories [example/api], got %v", included)
+	}
+
+	diags = flattenContainerRegistryConnectorExtraConfig(d, json.RawMessage(`{"scanFrequency":"WEEKLY"}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if frequency := d.Get("scan_frequency").(string); frequency != "WEEKLY" {
+		t.Errorf("Expected scan_frequency WEEKLY, got %s", frequency)
+	}
+	if included := d.Get("included_repositories").([]interface{}); len(included) != 0 {
+		t.Errorf("Expected no included repositories, got %v", included)
+	}
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorDockerHub() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect Docker Hub namespaces to Wiz for image scanning.",
+		Schema: containerRegistryConnectorSchema("Docker Hub", map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "The Docker Hub organization or user namespace to connect.",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The Docker Hub username that owns the access token.",
+				Required:    true,
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Description: "A Docker Hub personal or organization access token with read access to the namespace repositories.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		}),
+		CustomizeDiff: containerRegistryConnectorCustomizeDiff(
+			"namespace",
+			"username",
+			"access_token",
+		),
+		CreateContext: resourceWizConnectorDockerHubCreate,
+		ReadContext:   resourceWizConnectorDockerHubRead,
+		UpdateContext: resourceWizConnectorDockerHubUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorDockerHubCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorDockerHubCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsDockerHub{
+		Namespace: d.Get("namespace").(string),
+		Username:  d.Get("username").(string),
+		Token:     d.Get("access_token").(string),
+	}
+
+	diags = createContainerRegistryConnector(ctx, d, m, "dockerhub", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorDockerHubRead(ctx, d, m)
+}
+
+func resourceWizConnectorDockerHubRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorDockerHubRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readContainerRegistryConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsDockerHub
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsDockerHub: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"namespace": authParams.Namespace,
+		"username":  authParams.Username,
+	})
+}
+
+func resourceWizConnectorDockerHubUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorDockerHubUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateContainerRegistryConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorDockerHubRead(ctx, d, m)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorECR() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect Amazon Elastic Container Registry (ECR) registries outside of connected AWS accounts to Wiz for image scanning.",
+		Schema: containerRegistryConnectorSchema("ECR", map[string]*schema.Schema{
+			"registry_url": {
+				Type:        schema.TypeString,
+				Description: "The ECR registry URL (e.g. `123456789012.dkr.ecr.us-east-1.amazonaws.com`).",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsNotEmpty,
+				),
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Description: "The ARN of the IAM role Wiz assumes to pull images from the registry.",
+				Optional:    true,
+				ExactlyOneOf: []string{
+					"role_arn",
+					"access_key_id",
+				},
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The external ID required by the trust policy of `role_arn`.",
+				Optional:    true,
+				RequiredWith: []string{
+					"role_arn",
+				},
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Description: "The access key ID of an IAM user with pull access to the registry.",
+				Optional:    true,
+				RequiredWith: []string{
+					"secret_access_key",
+				},
+			},
+			"secret_access_key": {
+				Type:        schema.TypeString,
+				Description: "The secret access key of the IAM user.",
+				Optional:    true,
+				Sensitive:   true,
+				RequiredWith: []string{
+					"access_key_id",
+				},
+			},
+		}),
+		CustomizeDiff: containerRegistryConnectorCustomizeDiff(
+			"registry_url",
+			"role_arn",
+			"external_id",
+			"access_key_id",
+			"secret_access_key",
+		),
+		CreateContext: resourceWizConnectorECRCreate,
+		ReadContext:   resourceWizConnectorECRRead,
+		UpdateContext: resourceWizConnectorECRUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorECRCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorECRCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsECR{
+		RegistryURL:     d.Get("registry_url").(string),
+		CustomerRoleARN: d.Get("role_arn").(string),
+		ExternalIDNonce: d.Get("external_id").(string),
+		AccessKeyID:     d.Get("access_key_id").(string),
+		SecretAccessKey: d.Get("secret_access_key").(string),
+	}
+
+	diags = createContainerRegistryConnector(ctx, d, m, "ecr", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorECRRead(ctx, d, m)
+}
+
+func resourceWizConnectorECRRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorECRRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readContainerRegistryConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsECR
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsECR: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"registry_url":  authParams.RegistryURL,
+		"role_arn":      authParams.CustomerRoleARN,
+		"external_id":   authParams.ExternalIDNonce,
+		"access_key_id": authParams.AccessKeyID,
+	})
+}
+
+func resourceWizConnectorECRUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorECRUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateContainerRegistryConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorECRRead(ctx, d, m)
+}
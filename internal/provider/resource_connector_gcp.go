@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +29,16 @@ const (
 	subscriptionIDNameKey     = "subscriptionID"
 )
 
+// gcpConnectorExtraConfigDefaults maps the structured extra configuration attributes to the values they reset to when removed
+var gcpConnectorExtraConfigDefaults = map[string]interface{}{
+	"projects":                        []interface{}{},
+	"excluded_projects":               []interface{}{},
+	"included_folders":                []interface{}{},
+	"excluded_folders":                []interface{}{},
+	"audit_log_monitor_enabled":       false,
+	"disk_analyzer_inflight_disabled": false,
+}
+
 func resourceWizConnectorGcp() *schema.Resource {
 	return &schema.Resource{
 		Description: "Connectors are used to connect GCP resources to Wiz.",
@@ -48,6 +59,50 @@ func resourceWizConnectorGcp() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"wait_for_status": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Wait after creating or updating an enabled connector until it reaches one of these statuses, bounded by the `create` and `update` timeouts. A connector entering the `ERROR` status fails the apply, and a connector settling in another status outside this list ends the wait with a warning. When not set, the apply does not wait.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						connectorWaitStatus,
+					),
+				),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.StringInSlice(
+							connectorWaitStatus,
+							false,
+						),
+					),
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf(
+					"The connector status.\n    - Possible values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ConnectorStatus,
+					),
+				),
+			},
+			"error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf(
+					"The error code reported when the connector is in the `ERROR` status.\n    - Possible values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ConnectorErrorCode,
+					),
+				),
+			},
+			"cloud_account_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of cloud accounts discovered by the connector.",
+				Computed:    true,
+			},
 			"is_managed_identity": {
 				Type:        schema.TypeString,
 				Description: "Is managed identity?",
@@ -65,45 +120,69 @@ func resourceWizConnectorGcp() *schema.Resource {
 			},
 			"projects": {
 				Type:        schema.TypeList,
-				Description: "The GCP projects to target with the connector.",
+				Description: "The GCP projects to target with the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"excluded_projects": {
 				Type:        schema.TypeList,
-				Description: "The GCP projects excluded by the connector.",
+				Description: "The GCP projects excluded by the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"included_folders": {
 				Type:        schema.TypeList,
-				Description: "The GCP folders included by the connector.",
+				Description: "The GCP folders included by the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"excluded_folders": {
 				Type:        schema.TypeList,
-				Description: "The GCP folders excluded by the connector.",
+				Description: "The GCP folders excluded by the connector. Conflicts with `extra_config`, removing the attribute resets it to an empty list.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"audit_log_monitor_enabled": {
 				Type:        schema.TypeBool,
-				Description: "Whether audit log monitor is enabled. Note an advanced license is required.",
+				Description: "Whether audit log monitor is enabled. Note an advanced license is required. Conflicts with `extra_config`, removing the attribute resets it to `false`.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 			},
 			"disk_analyzer_inflight_disabled": {
 				Type:        schema.TypeBool,
-				Description: "If using Outpost, whether disk analyzer inflight scanning is disabled.",
+				Description: "If using Outpost, whether disk analyzer inflight scanning is disabled. Conflicts with `extra_config`, removing the attribute resets it to `false`.",
+				Optional:    true,
 				Computed:    true,
+				ConflictsWith: []string{
+					"extra_config",
+				},
 			},
 			"events_topic_name": {
 				Type:        schema.TypeString,
@@ -124,11 +203,13 @@ func resourceWizConnectorGcp() *schema.Resource {
 					validation.StringIsJSON,
 				),
 			},
+			"configured_extra_config_attributes": connectorConfiguredExtraConfigAttributesSchema(),
 			"extra_config": {
 				// these are JSON fields; the schema does not support overrides, once a field is set, future changes require it to be passed
 				Type:        schema.TypeString,
-				Description: "Extra configuration for the connector. Must be represented in `JSON` format.",
+				Description: "Extra configuration for the connector. Must be represented in `JSON` format. Once a field is set, future changes require it to be passed, prefer the structured attributes which detect drift and reset removed settings.",
 				Optional:    true,
+				Computed:    true,
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.StringIsJSON,
 				),
@@ -138,6 +219,7 @@ func resourceWizConnectorGcp() *schema.Resource {
 		// to accommodate for importing resources into state, we can't use `ForceNew` in the schema definition.
 		// we use a customdiff and `ForceNewIfChange` for below attributes for only a change condition.
 		CustomizeDiff: customdiff.All(
+			connectorExtraConfigCustomizeDiff(gcpConnectorExtraConfigDefaults),
 			customdiff.ForceNewIfChange("auth_params", func(ctx context.Context, old, new, meta any) bool {
 				if old.(string) != "" {
 					return old.(string) != new.(string)
@@ -146,6 +228,10 @@ func resourceWizConnectorGcp() *schema.Resource {
 			},
 			),
 		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		CreateContext: resourceWizConnectorGcpCreate,
 		ReadContext:   resourceWizConnectorGcpRead,
 		UpdateContext: resourceWizConnectorGcpUpdate,
@@ -175,7 +261,15 @@ func resourceWizConnectorGcpCreate(ctx context.Context, d *schema.ResourceData,
 	vars.Enabled = &enabled
 
 	vars.AuthParams = json.RawMessage(d.Get("auth_params").(string))
-	vars.ExtraConfig = json.RawMessage(d.Get("extra_config").(string))
+	if connectorExtraConfigStructured(d) {
+		extraConfig, err := expandConnectorGcpExtraConfig(d)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		vars.ExtraConfig = extraConfig
+	} else {
+		vars.ExtraConfig = json.RawMessage(d.Get("extra_config").(string))
+	}
 
 	// process the request
 	data := &CreateConnector{}
@@ -188,7 +282,9 @@ func resourceWizConnectorGcpCreate(ctx context.Context, d *schema.ResourceData,
 	// set the id
 	d.SetId(data.CreateConnector.Connector.ID)
 
-	return resourceWizConnectorGcpRead(ctx, d, m)
+	waitDiags := waitForConnectorStatus(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+
+	return appendConnectorReadDiags(waitDiags, resourceWizConnectorGcpRead(ctx, d, m))
 }
 
 func resourceWizConnectorGcpRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -205,6 +301,9 @@ func resourceWizConnectorGcpRead(ctx context.Context, d *schema.ResourceData, m
 	      id
 	      name
 	      enabled
+	      status
+	      errorCode
+	      cloudAccountCount
 	      authParams
 	      extraConfig
 	      config {
@@ -275,6 +374,18 @@ func resourceWizConnectorGcpRead(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	err = d.Set("status", data.Connector.Status)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("error_code", data.Connector.ErrorCode)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("cloud_account_count", data.Connector.CloudAccountCount)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
 
 	var connectorConfig wiz.ConnectorConfigGCP
 	connectorConfigBytes, err := json.Marshal(data.Connector.Config)
@@ -331,6 +442,11 @@ func resourceWizConnectorGcpRead(ctx context.Context, d *schema.ResourceData, m
 		return append(diags, diagsExtraConfig...)
 	}
 
+	// surface a failed connection without failing the refresh
+	if data.Connector.Status == connectorStatusError {
+		diags = append(diags, connectorErrorDiagnostic(diag.Warning, data.Connector))
+	}
+
 	return diags
 }
 
@@ -365,7 +481,15 @@ func resourceWizConnectorGcpUpdate(ctx context.Context, d *schema.ResourceData,
 		enabled := d.Get("enabled").(bool)
 		vars.Patch.Enabled = &enabled
 	}
-	if d.HasChange("extra_config") {
+	if connectorExtraConfigStructured(d) {
+		if d.HasChanges(connectorExtraConfigAttributes(gcpConnectorExtraConfigDefaults)...) {
+			extraConfig, err := expandConnectorGcpExtraConfig(d)
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+			vars.Patch.ExtraConfig = extraConfig
+		}
+	} else if d.HasChange("extra_config") {
 		vars.Patch.ExtraConfig = json.RawMessage(d.Get("extra_config").(string))
 	}
 
@@ -377,7 +501,13 @@ func resourceWizConnectorGcpUpdate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	return resourceWizConnectorGcpRead(ctx, d, m)
+	// only wait when the change can affect the connection
+	var waitDiags diag.Diagnostics
+	if d.HasChanges("enabled", "extra_config") {
+		waitDiags = waitForConnectorStatus(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+	}
+
+	return appendConnectorReadDiags(waitDiags, resourceWizConnectorGcpRead(ctx, d, m))
 }
 
 func resourceWizConnectorGcpDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -410,6 +540,20 @@ func resourceWizConnectorGcpDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+// expandConnectorGcpExtraConfig builds the extra configuration from the structured attributes, unset attributes are sent with their defaults to reset them
+func expandConnectorGcpExtraConfig(d *schema.ResourceData) (json.RawMessage, error) {
+	extraConfig := &wiz.ConnectorExtraConfigGCP{
+		Projects:                     utils.ConvertListToString(d.Get("projects").([]interface{})),
+		ExcludedProjects:             utils.ConvertListToString(d.Get("excluded_projects").([]interface{})),
+		IncludedFolders:              utils.ConvertListToString(d.Get("included_folders").([]interface{})),
+		ExcludedFolders:              utils.ConvertListToString(d.Get("excluded_folders").([]interface{})),
+		AuditLogMonitorEnabled:       d.Get("audit_log_monitor_enabled").(bool),
+		DiskAnalyzerInFlightDisabled: d.Get("disk_analyzer_inflight_disabled").(bool),
+	}
+
+	return json.Marshal(extraConfig)
+}
+
 // Wiz API limitations prevent nullifying the `auditLogsConfig/pub_sub` field. For example, disabling `auditLogMonitorEnabled`
 // will results in perpetual drift detection of extraConfig as the related pub_sub information will always be in the response once set.
 // Furthermore, additional `pub_sub `fields require normalization and removal of unnecessary fields.
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var extraConfigErrorSummary = "Invalid extra configuration"
@@ -45,3 +46,31 @@ func TestAddFieldError(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandConnectorGcpExtraConfig(t *testing.T) {
+	expected := `{"projects":["example-project"],"excludedProjects":[],"includedFolders":[],"excludedFolders":["folders/1234567890"],"auditLogMonitorEnabled":false,"diskAnalyzerInFlightDisabled":true}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorGcp().Schema,
+		map[string]interface{}{
+			"name":                            "example",
+			"projects":                        []interface{}{"example-project"},
+			"excluded_folders":                []interface{}{"folders/1234567890"},
+			"disk_analyzer_inflight_disabled": true,
+		},
+	)
+
+	extraConfig, err := expandConnectorGcpExtraConfig(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(extraConfig) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			extraConfig,
+			expected,
+		)
+	}
+}
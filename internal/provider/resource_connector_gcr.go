@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorGCR() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect Google Container Registry and Artifact Registry repositories outside of connected GCP projects to Wiz for image scanning.",
+		Schema: containerRegistryConnectorSchema("Container Registry or Artifact Registry", map[string]*schema.Schema{
+			"registry_url": {
+				Type:        schema.TypeString,
+				Description: "The Container Registry or Artifact Registry URL, including the project (e.g. `gcr.io/example-project` or `us-docker.pkg.dev/example-project/images`).",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsNotEmpty,
+				),
+			},
+			"service_account_key": {
+				Type:        schema.TypeString,
+				Description: "The JSON key of a GCP service account with the `Artifact Registry Reader` role. Must be represented in `JSON` format.",
+				Required:    true,
+				Sensitive:   true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+		}),
+		CustomizeDiff: containerRegistryConnectorCustomizeDiff(
+			"registry_url",
+			"service_account_key",
+		),
+		CreateContext: resourceWizConnectorGCRCreate,
+		ReadContext:   resourceWizConnectorGCRRead,
+		UpdateContext: resourceWizConnectorGCRUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorGCRCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGCRCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsGCR{
+		RegistryURL:       d.Get("registry_url").(string),
+		ServiceAccountKey: json.RawMessage(d.Get("service_account_key").(string)),
+	}
+
+	diags = createContainerRegistryConnector(ctx, d, m, "gcr", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorGCRRead(ctx, d, m)
+}
+
+func resourceWizConnectorGCRRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGCRRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readContainerRegistryConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsGCR
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsGCR: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"registry_url": authParams.RegistryURL,
+	})
+}
+
+func resourceWizConnectorGCRUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGCRUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateContainerRegistryConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorGCRRead(ctx, d, m)
+}
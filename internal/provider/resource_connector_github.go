@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorGitHub() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect GitHub organizations to Wiz for code scanning.",
+		Schema: vcsConnectorSchema("GitHub", map[string]*schema.Schema{
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The GitHub URL, set to the server URL when connecting GitHub Enterprise Server.",
+				Optional:    true,
+				Default:     "https://github.com",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsURLWithHTTPS,
+				),
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Description: "The GitHub organization to connect.",
+				Required:    true,
+			},
+			"personal_access_token": {
+				Type:        schema.TypeString,
+				Description: "A GitHub personal access token with read access to the organization repositories.",
+				Optional:    true,
+				Sensitive:   true,
+				ExactlyOneOf: []string{
+					"personal_access_token",
+					"app_id",
+				},
+			},
+			"app_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the GitHub App installed in the organization.",
+				Optional:    true,
+				RequiredWith: []string{
+					"app_installation_id",
+					"app_private_key",
+				},
+			},
+			"app_installation_id": {
+				Type:        schema.TypeString,
+				Description: "The installation ID of the GitHub App in the organization.",
+				Optional:    true,
+				RequiredWith: []string{
+					"app_id",
+				},
+			},
+			"app_private_key": {
+				Type:        schema.TypeString,
+				Description: "The PEM encoded private key of the GitHub App.",
+				Optional:    true,
+				Sensitive:   true,
+				RequiredWith: []string{
+					"app_id",
+				},
+			},
+		}),
+		CustomizeDiff: vcsConnectorCustomizeDiff(
+			"url",
+			"organization",
+			"personal_access_token",
+			"app_id",
+			"app_installation_id",
+			"app_private_key",
+		),
+		CreateContext: resourceWizConnectorGitHubCreate,
+		ReadContext:   resourceWizConnectorGitHubRead,
+		UpdateContext: resourceWizConnectorGitHubUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorGitHubCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGitHubCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsGitHub{
+		URL:            d.Get("url").(string),
+		Organization:   d.Get("organization").(string),
+		Token:          d.Get("personal_access_token").(string),
+		AppID:          d.Get("app_id").(string),
+		InstallationID: d.Get("app_installation_id").(string),
+		PrivateKey:     d.Get("app_private_key").(string),
+	}
+
+	diags = createVCSConnector(ctx, d, m, "github", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorGitHubRead(ctx, d, m)
+}
+
+func resourceWizConnectorGitHubRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGitHubRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readVCSConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsGitHub
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsGitHub: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"url":                 authParams.URL,
+		"organization":        authParams.Organization,
+		"app_id":              authParams.AppID,
+		"app_installation_id": authParams.InstallationID,
+	})
+}
+
+func resourceWizConnectorGitHubUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGitHubUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateVCSConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorGitHubRead(ctx, d, m)
+}
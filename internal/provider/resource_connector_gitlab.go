@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorGitLab() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect GitLab groups to Wiz for code scanning.",
+		Schema: vcsConnectorSchema("GitLab", map[string]*schema.Schema{
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The GitLab URL, set to the instance URL when connecting GitLab Self-Managed.",
+				Optional:    true,
+				Default:     "https://gitlab.com",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsURLWithHTTPS,
+				),
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Description: "The full path of the GitLab group to connect, including subgroups (e.g. `my-group/my-subgroup`).",
+				Required:    true,
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Description: "A GitLab personal or group access token with the `read_api` and `read_repository` scopes.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		}),
+		CustomizeDiff: vcsConnectorCustomizeDiff(
+			"url",
+			"group",
+			"access_token",
+		),
+		CreateContext: resourceWizConnectorGitLabCreate,
+		ReadContext:   resourceWizConnectorGitLabRead,
+		UpdateContext: resourceWizConnectorGitLabUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorGitLabCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGitLabCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsGitLab{
+		URL:   d.Get("url").(string),
+		Group: d.Get("group").(string),
+		Token: d.Get("access_token").(string),
+	}
+
+	diags = createVCSConnector(ctx, d, m, "gitlab", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorGitLabRead(ctx, d, m)
+}
+
+func resourceWizConnectorGitLabRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGitLabRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readVCSConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsGitLab
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsGitLab: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"url":   authParams.URL,
+		"group": authParams.Group,
+	})
+}
+
+func resourceWizConnectorGitLabUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorGitLabUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateVCSConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorGitLabRead(ctx, d, m)
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizConnectorJFrog() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect JFrog Artifactory Docker repositories to Wiz for image scanning.",
+		Schema: containerRegistryConnectorSchema("JFrog Artifactory", map[string]*schema.Schema{
+			"registry_url": {
+				Type:        schema.TypeString,
+				Description: "The JFrog Platform URL (e.g. `https://example.jfrog.io`).",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsURLWithHTTPS,
+				),
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The JFrog username that owns the access token.",
+				Required:    true,
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Description: "A JFrog access token with read access to the Docker repositories.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		}),
+		CustomizeDiff: containerRegistryConnectorCustomizeDiff(
+			"registry_url",
+			"username",
+			"access_token",
+		),
+		CreateContext: resourceWizConnectorJFrogCreate,
+		ReadContext:   resourceWizConnectorJFrogRead,
+		UpdateContext: resourceWizConnectorJFrogUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorJFrogCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorJFrogCreate called...")
+
+	authParams := &wiz.ConnectorAuthParamsJFrog{
+		RegistryURL: d.Get("registry_url").(string),
+		Username:    d.Get("username").(string),
+		Token:       d.Get("access_token").(string),
+	}
+
+	diags = createContainerRegistryConnector(ctx, d, m, "jfrog", authParams)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorJFrogRead(ctx, d, m)
+}
+
+func resourceWizConnectorJFrogRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorJFrogRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readContainerRegistryConnector(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	var authParams wiz.ConnectorAuthParamsJFrog
+	if len(data.Connector.AuthParams) > 0 {
+		err := json.Unmarshal(data.Connector.AuthParams, &authParams)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorAuthParamsJFrog: %v", err)...)
+		}
+	}
+
+	return setConnectorAuthAttributes(d, map[string]string{
+		"registry_url": authParams.RegistryURL,
+		"username":     authParams.Username,
+	})
+}
+
+func resourceWizConnectorJFrogUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorJFrogUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = updateContainerRegistryConnector(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorJFrogRead(ctx, d, m)
+}
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// kubernetesConnectorServiceAccountType lists the wiz.ServiceAccountType values used by the in-cluster Wiz components
+var kubernetesConnectorServiceAccountType = []string{
+	"BROKER",
+	"KUBERNETES_ADMISSION_CONTROLLER",
+}
+
+func resourceWizConnectorKubernetes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect self-managed and on-premises Kubernetes clusters to Wiz. The connector can optionally create the service account used by the in-cluster Wiz components, exposing its credentials for use with the Helm provider.",
+		Schema: connectorSchema(map[string]*schema.Schema{
+			"service_account_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: fmt.Sprintf(
+					"The type of service account to create alongside the connector. When set, a service account named after the connector is created and its credentials are exposed for the Helm deployment of the cluster components. Use `BROKER` for the Kubernetes connector and `KUBERNETES_ADMISSION_CONTROLLER` for the admission controller.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						kubernetesConnectorServiceAccountType,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						kubernetesConnectorServiceAccountType,
+						false,
+					),
+				),
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Description: "Wiz internal identifier for the service account created with the connector.",
+				Computed:    true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The client ID of the service account created with the connector.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "The client secret of the service account created with the connector. The secret is only returned when the service account is created, so it is not populated on import.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"auth_params": {
+				Type:        schema.TypeString,
+				Description: "The authentication parameters. Must be represented in `JSON` format. For clusters reached through the Wiz broker, set `isOnPrem` to `true` and provide the `serverEndpoint` of the Kubernetes API server; clusters with a public endpoint also require `serverCertificateAuthorityData` and a service account `token`.",
+				Required:    true,
+				Sensitive:   true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+			"extra_config": {
+				// these are JSON fields; the schema does not support overrides, once a field is set, future changes require it to be passed
+				Type:        schema.TypeString,
+				Description: "Extra configuration for the connector. Must be represented in `JSON` format.",
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+		}),
+		// auth_params requires a resource recreation as they cannot be updated.
+		// to accommodate for importing resources into state, we can't use `ForceNew` in the schema definition.
+		// we use a customdiff and `ForceNewIfChange` for below attributes for only a change condition.
+		CustomizeDiff: customdiff.All(connectorForceNewIfChange("auth_params")...),
+		CreateContext: resourceWizConnectorKubernetesCreate,
+		ReadContext:   resourceWizConnectorKubernetesRead,
+		UpdateContext: resourceWizConnectorKubernetesUpdate,
+		DeleteContext: resourceWizConnectorKubernetesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorKubernetesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorKubernetesCreate called...")
+
+	diags = createConnectorFromParams(ctx, d, m, "kubernetes", json.RawMessage(d.Get("auth_params").(string)), json.RawMessage(d.Get("extra_config").(string)))
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// create the service account used to deploy the cluster components
+	serviceAccountType := d.Get("service_account_type").(string)
+	if serviceAccountType != "" {
+		serviceAccount, serviceAccountDiags := createKubernetesConnectorServiceAccount(ctx, m, d.Get("name").(string), serviceAccountType)
+		diags = append(diags, serviceAccountDiags...)
+		if len(diags) > 0 {
+			return diags
+		}
+
+		err := d.Set("service_account_id", serviceAccount.ID)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("client_id", serviceAccount.ClientID)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("client_secret", serviceAccount.ClientSecret)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return resourceWizConnectorKubernetesRead(ctx, d, m)
+}
+
+func resourceWizConnectorKubernetesRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorKubernetesRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readConnectorFromParams(ctx, d, m, "")
+	if data == nil {
+		return diags
+	}
+
+	diags = append(diags, setConnectorExtraConfig(d, data.Connector.ExtraConfig)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	serviceAccountID := d.Get("service_account_id").(string)
+	if serviceAccountID == "" {
+		return diags
+	}
+
+	serviceAccount, serviceAccountDiags := readKubernetesConnectorServiceAccount(ctx, m, serviceAccountID)
+	diags = append(diags, serviceAccountDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	if serviceAccount.ID == "" {
+		// clearing the type forces a recreation when the configuration still requests a service account
+		tflog.Warn(ctx, fmt.Sprintf("service account %s was deleted outside Terraform.", serviceAccountID))
+		err := d.Set("service_account_type", "")
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("service_account_id", "")
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("client_id", "")
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("client_secret", "")
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+
+	err := d.Set("service_account_type", serviceAccount.Type)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("client_id", serviceAccount.ClientID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizConnectorKubernetesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorKubernetesUpdate called...")
+
+	var extraConfig json.RawMessage
+	if d.HasChange("extra_config") {
+		extraConfig = json.RawMessage(d.Get("extra_config").(string))
+	}
+
+	diags = updateConnectorFromParams(ctx, d, m, extraConfig)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorKubernetesRead(ctx, d, m)
+}
+
+func resourceWizConnectorKubernetesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorKubernetesDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = resourceWizConnectorDelete(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// delete the service account created with the connector
+	serviceAccountID := d.Get("service_account_id").(string)
+	if serviceAccountID != "" {
+		diags = append(diags, deleteKubernetesConnectorServiceAccount(ctx, m, serviceAccountID)...)
+	}
+
+	return diags
+}
+
+// createKubernetesConnectorServiceAccount creates the service account used by the in-cluster components of a Kubernetes connector
+func createKubernetesConnectorServiceAccount(ctx context.Context, m interface{}, name string, serviceAccountType string) (serviceAccount wiz.ServiceAccount, diags diag.Diagnostics) {
+	tflog.Info(ctx, "createKubernetesConnectorServiceAccount called...")
+
+	// define the graphql query
+	query := `mutation CreateServiceAccount($input: CreateServiceAccountInput!) {
+	    createServiceAccount(input: $input) {
+	        serviceAccount {
+	            id
+	            clientId
+	            clientSecret
+	            type
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.CreateServiceAccountInput{}
+	vars.Name = name
+	vars.Type = &serviceAccountType
+
+	// process the request
+	data := &CreateServiceAccount{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "service_account", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return serviceAccount, diags
+	}
+
+	return data.CreateServiceAccount.ServiceAccount, diags
+}
+
+// readKubernetesConnectorServiceAccount reads the service account created with a Kubernetes connector, an empty ID is returned if it no longer exists
+func readKubernetesConnectorServiceAccount(ctx context.Context, m interface{}, id string) (serviceAccount wiz.ServiceAccount, diags diag.Diagnostics) {
+	tflog.Info(ctx, "readKubernetesConnectorServiceAccount called...")
+
+	// define the graphql query
+	query := `query ServiceAccount (
+	    $id: ID!
+	) {
+	    serviceAccount(
+	        id: $id
+	    ) {
+	        id
+	        clientId
+	        type
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = id
+
+	// process the request
+	data := &ReadServiceAccountPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "service_account", "read")
+	if len(requestDiags) > 0 && data.ServiceAccount.ID != "" {
+		return serviceAccount, append(diags, requestDiags...)
+	}
+
+	return data.ServiceAccount, diags
+}
+
+// deleteKubernetesConnectorServiceAccount deletes the service account created with a Kubernetes connector
+func deleteKubernetesConnectorServiceAccount(ctx context.Context, m interface{}, id string) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "deleteKubernetesConnectorServiceAccount called...")
+
+	// define the graphql query
+	query := `mutation DeleteServiceAccount (
+	    $input: DeleteServiceAccountInput!
+	) {
+	    deleteServiceAccount(
+	        input: $input
+	    ) {
+	        _stub
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.DeleteServiceAccountInput{}
+	vars.ID = id
+
+	// process the request
+	data := &wiz.DeleteServiceAccountPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "service_account", "delete")
+	diags = append(diags, requestDiags...)
+
+	return diags
+}
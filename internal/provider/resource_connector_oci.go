@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// ociConnectorConfigFragment selects the OCI fields of the connector config union
+const ociConnectorConfigFragment = `
+	      config {
+	        ... on ConnectorConfigOCI {
+	          tenancyOCID
+	          homeRegion
+	          userOCID
+	          fingerprint
+	          includedCompartments
+	          excludedCompartments
+	          diskAnalyzerInFlightDisabled
+	        }
+	      }`
+
+func resourceWizConnectorOci() *schema.Resource {
+	return &schema.Resource{
+		Description: "Connectors are used to connect OCI resources to Wiz.",
+		Schema: connectorSchema(map[string]*schema.Schema{
+			"tenancy_ocid": {
+				Type:        schema.TypeString,
+				Description: "The OCID of the OCI tenancy.",
+				Computed:    true,
+			},
+			"home_region": {
+				Type:        schema.TypeString,
+				Description: "The home region of the OCI tenancy.",
+				Computed:    true,
+			},
+			"user_ocid": {
+				Type:        schema.TypeString,
+				Description: "The OCID of the OCI user whose API key Wiz authenticates with.",
+				Computed:    true,
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Description: "The fingerprint of the OCI API signing key.",
+				Computed:    true,
+			},
+			"included_compartments": {
+				Type:        schema.TypeList,
+				Description: "The OCI compartments included by the connector.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"excluded_compartments": {
+				Type:        schema.TypeList,
+				Description: "The OCI compartments excluded by the connector.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"disk_analyzer_inflight_disabled": {
+				Type:        schema.TypeBool,
+				Description: "If using Outpost, whether disk analyzer inflight scanning is disabled.",
+				Computed:    true,
+			},
+			"auth_params": {
+				Type:        schema.TypeString,
+				Description: "The authentication parameters. Must be represented in `JSON` format. Authenticate with an API signing key using `tenancyOCID`, `userOCID`, `fingerprint`, `privateKey` and `homeRegion`. If using outposts, also include `outPostId` and `diskAnalyzer` structure.",
+				Required:    true,
+				Sensitive:   true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+			"extra_config": {
+				// these are JSON fields; the schema does not support overrides, once a field is set, future changes require it to be passed
+				Type:        schema.TypeString,
+				Description: "Extra configuration for the connector. Must be represented in `JSON` format.",
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+		}),
+		// auth_params requires a resource recreation as they cannot be updated.
+		// to accommodate for importing resources into state, we can't use `ForceNew` in the schema definition.
+		// we use a customdiff and `ForceNewIfChange` for below attributes for only a change condition.
+		CustomizeDiff: customdiff.All(connectorForceNewIfChange("auth_params")...),
+		CreateContext: resourceWizConnectorOciCreate,
+		ReadContext:   resourceWizConnectorOciRead,
+		UpdateContext: resourceWizConnectorOciUpdate,
+		DeleteContext: resourceWizConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizConnectorOciCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorOciCreate called...")
+
+	diags = createConnectorFromParams(ctx, d, m, "oci", json.RawMessage(d.Get("auth_params").(string)), json.RawMessage(d.Get("extra_config").(string)))
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorOciRead(ctx, d, m)
+}
+
+func resourceWizConnectorOciRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorOciRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readConnectorFromParams(ctx, d, m, ociConnectorConfigFragment)
+	if data == nil {
+		return diags
+	}
+
+	diags = append(diags, setConnectorExtraConfig(d, data.Connector.ExtraConfig)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var connectorConfig wiz.ConnectorConfigOCI
+	connectorConfigBytes, err := json.Marshal(data.Connector.Config)
+	if err != nil {
+		return append(diags, diag.Errorf("unable to marshal ConnectorConfigOCI: %v", err)...)
+	}
+	if err := json.Unmarshal(connectorConfigBytes, &connectorConfig); err != nil {
+		return append(diags, diag.Errorf("unable to unmarshal ConnectorConfigOCI: %v", err)...)
+	}
+
+	err = d.Set("tenancy_ocid", connectorConfig.TenancyOCID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("home_region", connectorConfig.HomeRegion)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("user_ocid", connectorConfig.UserOCID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("fingerprint", connectorConfig.Fingerprint)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("included_compartments", utils.ConvertSliceToGenericArray(connectorConfig.IncludedCompartments))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("excluded_compartments", utils.ConvertSliceToGenericArray(connectorConfig.ExcludedCompartments))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("disk_analyzer_inflight_disabled", connectorConfig.DiskAnalyzerInFlightDisabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizConnectorOciUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizConnectorOciUpdate called...")
+
+	var extraConfig json.RawMessage
+	if d.HasChange("extra_config") {
+		extraConfig = json.RawMessage(d.Get("extra_config").(string))
+	}
+
+	diags = updateConnectorFromParams(ctx, d, m, extraConfig)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizConnectorOciRead(ctx, d, m)
+}
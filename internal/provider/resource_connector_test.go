@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestConnectorPendingStatus(t *testing.T) {
+	expected := []string{
+		"INITIAL_SCANNING",
+	}
+
+	pending := connectorPendingStatus()
+
+	if !reflect.DeepEqual(pending, expected) {
+		t.Fatalf("Expected pending statuses %v, got %v", expected, pending)
+	}
+}
+
+func TestAppendConnectorReadDiags(t *testing.T) {
+	warning := diag.Diagnostic{Severity: diag.Warning, Summary: "Connector example failed to connect."}
+	readError := diag.Diagnostic{Severity: diag.Error, Summary: "read failed"}
+
+	// a successful wait keeps every read diagnostic
+	diags := appendConnectorReadDiags(nil, diag.Diagnostics{warning})
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected the read warning, got %v", diags)
+	}
+
+	// a failed wait drops the read warnings it already reported
+	waitError := diag.Diagnostic{Severity: diag.Error, Summary: "Connector example failed to connect."}
+	diags = appendConnectorReadDiags(diag.Diagnostics{waitError}, diag.Diagnostics{warning, readError})
+	expected := diag.Diagnostics{waitError, readError}
+	if !reflect.DeepEqual(diags, expected) {
+		t.Fatalf("Expected %v, got %v", expected, diags)
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// vcsConnectorSchema returns the attributes shared by the version control system connectors merged with the connector specific attributes
+func vcsConnectorSchema(vcsName string, attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	vcsSchema := map[string]*schema.Schema{
+		"included_repositories": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("The %s repositories to scan. When not set, all repositories in scope are scanned.", vcsName),
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"excluded_repositories": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("The %s repositories to exclude from scanning.", vcsName),
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"pull_request_scanning_enabled": {
+			Type:        schema.TypeBool,
+			Description: "Whether pull requests are scanned.",
+			Optional:    true,
+			Default:     false,
+		},
+		"pull_request_comments_enabled": {
+			Type:        schema.TypeBool,
+			Description: "Whether scan findings are posted as pull request comments. Requires `pull_request_scanning_enabled`.",
+			Optional:    true,
+			Default:     false,
+		},
+	}
+
+	for name, attribute := range attributes {
+		vcsSchema[name] = attribute
+	}
+
+	return connectorSchema(vcsSchema)
+}
+
+// vcsConnectorCustomizeDiff validates the pull request settings and forces a new resource when any of the authentication attributes change
+func vcsConnectorCustomizeDiff(authAttributes ...string) schema.CustomizeDiffFunc {
+	funcs := []schema.CustomizeDiffFunc{
+		func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if d.Get("pull_request_comments_enabled").(bool) && !d.Get("pull_request_scanning_enabled").(bool) {
+				return fmt.Errorf("pull_request_comments_enabled requires pull_request_scanning_enabled")
+			}
+			return nil
+		},
+	}
+
+	return customdiff.All(append(funcs, connectorForceNewIfChange(authAttributes...)...)...)
+}
+
+// expandVCSConnectorExtraConfig builds the extra configuration shared by the version control system connectors
+func expandVCSConnectorExtraConfig(d *schema.ResourceData) (json.RawMessage, error) {
+	extraConfig := &wiz.ConnectorExtraConfigVCS{
+		IncludedRepositories:       utils.ConvertListToString(d.Get("included_repositories").([]interface{})),
+		ExcludedRepositories:       utils.ConvertListToString(d.Get("excluded_repositories").([]interface{})),
+		PullRequestScanningEnabled: d.Get("pull_request_scanning_enabled").(bool),
+		PullRequestCommentsEnabled: d.Get("pull_request_comments_enabled").(bool),
+	}
+
+	return json.Marshal(extraConfig)
+}
+
+// flattenVCSConnectorExtraConfig sets the attributes derived from the extra configuration of a version control system connector
+func flattenVCSConnectorExtraConfig(d *schema.ResourceData, rawExtraConfig json.RawMessage) (diags diag.Diagnostics) {
+	var extraConfig wiz.ConnectorExtraConfigVCS
+	if len(rawExtraConfig) > 0 {
+		err := json.Unmarshal(rawExtraConfig, &extraConfig)
+		if err != nil {
+			return append(diags, diag.Errorf("unable to unmarshal ConnectorExtraConfigVCS: %v", err)...)
+		}
+	}
+
+	err := d.Set("included_repositories", utils.ConvertSliceToGenericArray(extraConfig.IncludedRepositories))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("excluded_repositories", utils.ConvertSliceToGenericArray(extraConfig.ExcludedRepositories))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("pull_request_scanning_enabled", extraConfig.PullRequestScanningEnabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("pull_request_comments_enabled", extraConfig.PullRequestCommentsEnabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// createVCSConnector creates a version control system connector and sets the resource id
+func createVCSConnector(ctx context.Context, d *schema.ResourceData, m interface{}, connectorType string, authParams interface{}) (diags diag.Diagnostics) {
+	extraConfig, err := expandVCSConnectorExtraConfig(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return createConnectorFromParams(ctx, d, m, connectorType, authParams, extraConfig)
+}
+
+// readVCSConnector reads a version control system connector and sets the shared attributes, a nil payload is returned if the connector no longer exists
+func readVCSConnector(ctx context.Context, d *schema.ResourceData, m interface{}) (*ReadConnectorPayload, diag.Diagnostics) {
+	data, diags := readConnectorFromParams(ctx, d, m, "")
+	if data == nil {
+		return nil, diags
+	}
+
+	diags = append(diags, flattenVCSConnectorExtraConfig(d, data.Connector.ExtraConfig)...)
+	if len(diags) > 0 {
+		return nil, diags
+	}
+
+	return data, diags
+}
+
+// updateVCSConnector updates the name, enabled state and extra configuration of a version control system connector
+func updateVCSConnector(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	var extraConfig json.RawMessage
+	if d.HasChanges("included_repositories", "excluded_repositories", "pull_request_scanning_enabled", "pull_request_comments_enabled") {
+		var err error
+		extraConfig, err = expandVCSConnectorExtraConfig(d)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return updateConnectorFromParams(ctx, d, m, extraConfig)
+}
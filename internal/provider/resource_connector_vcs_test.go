@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandVCSConnectorExtraConfig(t *testing.T) {
+	expected := `{"includedRepositories":["api","web"],"pullRequestScanningEnabled":true,"pullRequestCommentsEnabled":true}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorGitHub().Schema,
+		map[string]interface{}{
+			"name":         "example",
+			"organization": "example",
+			"included_repositories": []interface{}{
+				"api",
+				"web",
+			},
+			"pull_request_scanning_enabled": true,
+			"pull_request_comments_enabled": true,
+		},
+	)
+
+	extraConfig, err := expandVCSConnectorExtraConfig(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(extraConfig) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			extraConfig,
+			expected,
+		)
+	}
+}
+
+func TestFlattenVCSConnectorExtraConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizConnectorGitLab().Schema,
+		map[string]interface{}{
+			"included_repositories": []interface{}{
+				"stale",
+			},
+			"pull_request_comments_enabled": true,
+		},
+	)
+
+	diags := flattenVCSConnectorExtraConfig(d, json.RawMessage(`{"excludedRepositories":["legacy"],"pullRequestScanningEnabled":true,"pullRequestCommentsEnabled":false}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if included := d.Get("included_repositories").([]interface{}); len(included) != 0 {
+		t.Errorf("Expected no included repositories, got %v", included)
+	}
+	expectedExcluded := []interface{}{"legacy"}
+	if excluded := d.Get("excluded_repositories").([]interface{}); !reflect.DeepEqual(excluded, expectedExcluded) {
+		t.Errorf("Expected excluded repositories %v, got %v", expectedExcluded, excluded)
+	}
+	if !d.Get("pull_request_scanning_enabled").(bool) {
+		t.Error("Expected pull_request_scanning_enabled to be true")
+	}
+	if d.Get("pull_request_comments_enabled").(bool) {
+		t.Error("Expected pull_request_comments_enabled to be false")
+	}
+}
@@ -1,6 +1,21 @@
 package provider
 
 import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
 
@@ -23,3 +38,340 @@ type UpdateHostConfigurationRule struct {
 type DeleteHostConfigurationRule struct {
 	DeleteHostConfigurationRule wiz.DeleteHostConfigurationRulePayload `json:"deleteHostConfigurationRule"`
 }
+
+func resourceWizHostConfigurationRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Host Configuration Rule is an OVAL check assessed on hosts during disk scanning—if a host does not pass a Rule, a Host Configuration Finding is generated and associated with the host on the Security Graph.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Wiz internal identifier.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of this rule, as appeared in the UI in the portal.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Detailed description for this rule.",
+			},
+			"direct_oval": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "OVAL definition assessed on hosts during disk scanning. Must be a well-formed XML document with an `oval_definitions` root element; this is validated during plan.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validateHostConfigurationRuleOVAL,
+				),
+			},
+			"target_platform_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Wiz identifiers of the technologies (platforms) the rule is targeting, e.g. Ubuntu, RedHat, NGINX. The target platforms cannot be nullified after they are defined; removing this argument keeps the current platforms.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable/disable this rule.",
+				Default:     true,
+			},
+			"security_sub_categories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Associate this rule with security sub-categories to easily monitor your compliance. New Host Configuration Findings created by this rule will be tagged with the selected sub-categories. The security sub-categories cannot be nullified after they are defined; removing this argument keeps the current sub-categories.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An external id for the rule.",
+			},
+			"short_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A short name that identifies the rule.",
+			},
+		},
+		CreateContext: resourceWizHostConfigurationRuleCreate,
+		ReadContext:   resourceWizHostConfigurationRuleRead,
+		UpdateContext: resourceWizHostConfigurationRuleUpdate,
+		DeleteContext: resourceWizHostConfigurationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// validateHostConfigurationRuleOVAL ensures the OVAL definition is a well-formed XML document with an oval_definitions root element
+func validateHostConfigurationRuleOVAL(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errs
+	}
+
+	root := ""
+	depth := 0
+	decoder := xml.NewDecoder(strings.NewReader(v))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s is not well-formed XML: %w", k, err))
+			return warnings, errs
+		}
+
+		// the decoder does not enforce a single root element, so track the depth to check it here
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth == 0 && root != "" {
+				errs = append(errs, fmt.Errorf("%s is not well-formed XML: multiple root elements", k))
+				return warnings, errs
+			}
+			if depth == 0 {
+				root = t.Name.Local
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && len(strings.TrimSpace(string(t))) > 0 {
+				errs = append(errs, fmt.Errorf("%s is not well-formed XML: content outside of the root element", k))
+				return warnings, errs
+			}
+		}
+	}
+
+	if root != "oval_definitions" {
+		errs = append(errs, fmt.Errorf("%s must have an oval_definitions root element, got %q", k, root))
+	}
+
+	return warnings, errs
+}
+
+func resourceWizHostConfigurationRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizHostConfigurationRuleCreate called...")
+
+	// define the graphql query
+	query := `mutation CreateHostConfigurationRule(
+	    $input: CreateHostConfigurationRuleInput!
+	) {
+	    createHostConfigurationRule(
+	        input: $input
+	    ) {
+	        rule {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.CreateHostConfigurationRuleInput{}
+	vars.Name = d.Get("name").(string)
+	vars.Description = d.Get("description").(string)
+	vars.DirectOVAL = d.Get("direct_oval").(string)
+	vars.TargetPlatformIds = utils.ConvertListToString(d.Get("target_platform_ids").(*schema.Set).List())
+	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+	vars.SecuritySubCategories = utils.ConvertListToString(d.Get("security_sub_categories").(*schema.Set).List())
+
+	// process the request
+	data := &CreateHostConfigurationRule{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "host_configuration_rule", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id and computed values
+	d.SetId(data.CreateHostConfigurationRule.Rule.ID)
+
+	return resourceWizHostConfigurationRuleRead(ctx, d, m)
+}
+
+func resourceWizHostConfigurationRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizHostConfigurationRuleRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query hostConfigurationRule (
+	    $id: ID!
+	){
+	    hostConfigurationRule(
+	        id: $id
+	    ) {
+	        id
+	        externalId
+	        name
+	        shortName
+	        description
+	        enabled
+	        directOVAL
+	        securitySubCategories {
+	            id
+	        }
+	        targetPlatforms {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadHostConfigurationRulePayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "host_configuration_rule", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.HostConfigurationRule.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.HostConfigurationRule.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("description", data.HostConfigurationRule.Description)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("direct_oval", data.HostConfigurationRule.DirectOVAL)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("enabled", data.HostConfigurationRule.Enabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("external_id", data.HostConfigurationRule.ExternalID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("short_name", data.HostConfigurationRule.ShortName)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	targetPlatformIDs := flattenTargetPlatformIDs(ctx, data.HostConfigurationRule.TargetPlatforms)
+	if err := d.Set("target_platform_ids", targetPlatformIDs); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	securitySubCategories := flattenSecuritySubCategoriesID(ctx, data.HostConfigurationRule.SecuritySubCategories)
+	if err := d.Set("security_sub_categories", securitySubCategories); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizHostConfigurationRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizHostConfigurationRuleUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateHostConfigurationRule(
+	    $input: UpdateHostConfigurationRuleInput!
+	) {
+	    updateHostConfigurationRule(
+	        input: $input
+	    ) {
+	        rule {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateHostConfigurationRuleInput{}
+	vars.ID = d.Id()
+	// check if changes were made to required fields
+	if d.HasChange("name") {
+		vars.Patch.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		vars.Patch.Description = &description
+	}
+	if d.HasChange("direct_oval") {
+		vars.Patch.DirectOVAL = d.Get("direct_oval").(string)
+	}
+	if d.HasChange("target_platform_ids") {
+		vars.Patch.TargetPlatformIds = utils.ConvertListToString(d.Get("target_platform_ids").(*schema.Set).List())
+	}
+	if d.HasChange("security_sub_categories") {
+		vars.Patch.SecuritySubCategories = utils.ConvertListToString(d.Get("security_sub_categories").(*schema.Set).List())
+	}
+	// include all optional fields in the patch in the event they were nullified
+	vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+
+	// process the request
+	data := &UpdateHostConfigurationRule{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "host_configuration_rule", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizHostConfigurationRuleRead(ctx, d, m)
+}
+
+func resourceWizHostConfigurationRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizHostConfigurationRuleDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation DeleteHostConfigurationRule (
+	    $input: DeleteHostConfigurationRuleInput!
+	) {
+	    deleteHostConfigurationRule (
+	        input: $input
+	    ) {
+	        _stub
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.DeleteHostConfigurationRuleInput{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &DeleteHostConfigurationRule{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "host_configuration_rule", "delete")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestValidateHostConfigurationRuleOVAL(t *testing.T) {
+	valid := []string{
+		`<?xml version="1.0" encoding="UTF-8"?>
+<oval_definitions xmlns="http://oval.mitre.org/XMLSchema/oval-definitions-5">
+  <definitions>
+    <definition id="oval:com.example:def:1" version="1" class="compliance">
+      <metadata>
+        <title>Ensure SSH root login is disabled</title>
+      </metadata>
+    </definition>
+  </definitions>
+</oval_definitions>`,
+		`<oval:oval_definitions xmlns:oval="http://oval.mitre.org/XMLSchema/oval-definitions-5"></oval:oval_definitions>`,
+	}
+	for _, v := range valid {
+		_, errs := validateHostConfigurationRuleOVAL(v, "direct_oval")
+		if len(errs) != 0 {
+			t.Fatalf("Got errors for valid OVAL:\n\n%s\n\n%v", v, errs)
+		}
+	}
+
+	invalid := []string{
+		``,
+		`not xml`,
+		`<oval_definitions><definitions></oval_definitions>`,
+		`<oval_definitions></oval_definitions><oval_definitions></oval_definitions>`,
+		`<oval_definitions></oval_definitions>trailing`,
+		`<definitions></definitions>`,
+	}
+	for _, v := range invalid {
+		_, errs := validateHostConfigurationRuleOVAL(v, "direct_oval")
+		if len(errs) == 0 {
+			t.Fatalf("Expected errors for invalid OVAL:\n\n%s", v)
+		}
+	}
+}
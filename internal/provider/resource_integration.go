@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -78,3 +79,18 @@ func convertIntegrationScopeToBool(integrationScope string) *bool {
 
 	return &value
 }
+
+// integrationAccessMethodCustomizeDiff ensures the attribute required by the selected access method is configured
+func integrationAccessMethodCustomizeDiff(accessMethodAttribute string, requiredAttributes map[string]string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		accessMethod := d.Get(accessMethodAttribute).(string)
+		attribute, ok := requiredAttributes[accessMethod]
+		if !ok {
+			return nil
+		}
+		if d.GetRawConfig().GetAttr(attribute).IsNull() {
+			return fmt.Errorf("%s is required when %s is %s", attribute, accessMethodAttribute, accessMethod)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizIntegrationAzureServiceBus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Azure Service Bus integrations send templated messages to an Azure Service Bus queue or topic.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Identifier for this object.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the integration.",
+				Required:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Identifies the date and time when the object was created.",
+				Computed:    true,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The project this action is scoped to.",
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "All Resources, Restrict this Integration to global roles only",
+				Description: fmt.Sprintf(
+					"Scoping to a selected Project makes this Integration accessible only to users with global roles or Project-scoped access to the selected Project. Other users will not be able to see it, use it, or view its results. Integrations restricted to global roles cannot be seen or used by users with Project-scoped roles. \n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						internal.IntegrationScope,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						internal.IntegrationScope,
+						false,
+					),
+				),
+			},
+			"azure_service_bus_queue_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the Azure Service Bus queue or topic, for example `https://<namespace>.servicebus.windows.net/<queue>`.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsURLWithHTTPS,
+				),
+			},
+			"azure_service_bus_access_method": {
+				Required: true,
+				Type:     schema.TypeString,
+				Description: fmt.Sprintf(
+					"The access method this integration should use. \n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.AzureServiceBusAutomationActionAccessMethodType,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.AzureServiceBusAutomationActionAccessMethodType,
+						false,
+					),
+				),
+			},
+			"azure_service_bus_connector_id": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Required if and only if the access method is CONNECTOR_CREDENTIALS, this should be a valid existing Azure connector ID whose credentials will be used.",
+				ConflictsWith: []string{
+					"azure_service_bus_connection_string_with_sas",
+				},
+			},
+			"azure_service_bus_connection_string_with_sas": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Description: "Required if and only if the access method is CONNECTION_STRING_WITH_SAS, this is a connection string with a shared access signature (SAS) that allows sending messages.",
+				ConflictsWith: []string{
+					"azure_service_bus_connector_id",
+				},
+			},
+		},
+		CustomizeDiff: integrationAccessMethodCustomizeDiff(
+			"azure_service_bus_access_method",
+			map[string]string{
+				"CONNECTOR_CREDENTIALS":      "azure_service_bus_connector_id",
+				"CONNECTION_STRING_WITH_SAS": "azure_service_bus_connection_string_with_sas",
+			},
+		),
+		CreateContext: resourceWizIntegrationAzureServiceBusCreate,
+		ReadContext:   resourceWizIntegrationAzureServiceBusRead,
+		UpdateContext: resourceWizIntegrationAzureServiceBusUpdate,
+		DeleteContext: resourceWizIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizIntegrationAzureServiceBusCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationAzureServiceBusCreate called...")
+
+	// define the graphql query
+	query := `mutation CreateIntegration($input: CreateIntegrationInput!) {
+	  createIntegration(
+	    input: $input
+	  ) {
+	    integration {
+	      id
+	    }
+	  }
+	}`
+
+	vars := &wiz.CreateIntegrationInput{}
+	vars.Name = d.Get("name").(string)
+	vars.Type = "AZURE_SERVICE_BUS"
+	vars.ProjectID = d.Get("project_id").(string)
+	vars.IsAccessibleToAllProjects = convertIntegrationScopeToBool(d.Get("scope").(string))
+	vars.Params.AzureServiceBus = &wiz.CreateAzureServiceBusIntegrationParamsInput{}
+	vars.Params.AzureServiceBus.QueueURL = d.Get("azure_service_bus_queue_url").(string)
+	vars.Params.AzureServiceBus.AccessMethod.Type = d.Get("azure_service_bus_access_method").(string)
+	vars.Params.AzureServiceBus.AccessMethod.AccessConnectorID = d.Get("azure_service_bus_connector_id").(string)
+	vars.Params.AzureServiceBus.AccessMethod.ConnectionStringWithSas = d.Get("azure_service_bus_connection_string_with_sas").(string)
+
+	// process the request
+	data := &CreateIntegration{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_azure_service_bus", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateIntegration.Integration.ID)
+
+	return resourceWizIntegrationAzureServiceBusRead(ctx, d, m)
+}
+
+func resourceWizIntegrationAzureServiceBusRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationAzureServiceBusRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query integration (
+	  $id: ID!
+	) {
+	  integration(
+	    id: $id
+	  ) {
+	    id
+	    name
+	    createdAt
+	    updatedAt
+	    project {
+	      id
+	    }
+	    type
+	    isAccessibleToAllProjects
+	    usedByRules {
+	      id
+	    }
+	    paramsType: params {
+	      type: __typename
+	    }
+	    params {
+	      ... on AzureServiceBusIntegrationParams {
+	        queueUrl
+	        accessMethod
+	        accessConnector {
+	          id
+	        }
+	      }
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadIntegrationPayload{}
+	params := &wiz.AzureServiceBusIntegrationParams{}
+	data.Integration.Params = params
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_azure_service_bus", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.Integration.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.Integration.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("created_at", data.Integration.CreatedAt)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("project_id", data.Integration.Project.ID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("azure_service_bus_queue_url", params.QueueURL)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("azure_service_bus_access_method", params.AccessMethod)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("azure_service_bus_connector_id", params.AccessConnector.ID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("azure_service_bus_connection_string_with_sas", d.Get("azure_service_bus_connection_string_with_sas").(string))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizIntegrationAzureServiceBusUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationAzureServiceBusUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateIntegration(
+	  $input: UpdateIntegrationInput!
+	) {
+	  updateIntegration(input: $input) {
+	    integration {
+	      id
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateIntegrationInput{}
+	vars.ID = d.Id()
+	vars.Patch.Name = d.Get("name").(string)
+	vars.Patch.Params.AzureServiceBus = &wiz.UpdateAzureServiceBusIntegrationParamsInput{}
+	vars.Patch.Params.AzureServiceBus.QueueURL = d.Get("azure_service_bus_queue_url").(string)
+	vars.Patch.Params.AzureServiceBus.AccessMethod.Type = d.Get("azure_service_bus_access_method").(string)
+	vars.Patch.Params.AzureServiceBus.AccessMethod.AccessConnectorID = d.Get("azure_service_bus_connector_id").(string)
+	vars.Patch.Params.AzureServiceBus.AccessMethod.ConnectionStringWithSas = d.Get("azure_service_bus_connection_string_with_sas").(string)
+
+	// process the request
+	data := &UpdateIntegration{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_azure_service_bus", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizIntegrationGcpPubSub() *schema.Resource {
+	return &schema.Resource{
+		Description: "GCP Pub/Sub integrations publish templated messages to a Google Cloud Pub/Sub topic.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Identifier for this object.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the integration.",
+				Required:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Identifies the date and time when the object was created.",
+				Computed:    true,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The project this action is scoped to.",
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "All Resources, Restrict this Integration to global roles only",
+				Description: fmt.Sprintf(
+					"Scoping to a selected Project makes this Integration accessible only to users with global roles or Project-scoped access to the selected Project. Other users will not be able to see it, use it, or view its results. Integrations restricted to global roles cannot be seen or used by users with Project-scoped roles. \n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						internal.IntegrationScope,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						internal.IntegrationScope,
+						false,
+					),
+				),
+			},
+			"gcp_pub_sub_project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the GCP project containing the Pub/Sub topic.",
+			},
+			"gcp_pub_sub_topic_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Pub/Sub topic.",
+			},
+			"gcp_pub_sub_access_method": {
+				Required: true,
+				Type:     schema.TypeString,
+				Description: fmt.Sprintf(
+					"The access method this integration should use. \n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.GooglePubSubAutomationActionAccessMethodType,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.GooglePubSubAutomationActionAccessMethodType,
+						false,
+					),
+				),
+			},
+			"gcp_pub_sub_connector_id": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Required if and only if the access method is CONNECTOR_CREDENTIALS, this should be a valid existing GCP connector ID whose service account will be used.",
+				ConflictsWith: []string{
+					"gcp_pub_sub_service_account_key",
+				},
+			},
+			"gcp_pub_sub_service_account_key": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Description: "Required if and only if the access method is SERVICE_ACCOUNT_KEY, this is the JSON key of a service account allowed to publish to the topic.",
+				ConflictsWith: []string{
+					"gcp_pub_sub_connector_id",
+				},
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+		},
+		CustomizeDiff: integrationAccessMethodCustomizeDiff(
+			"gcp_pub_sub_access_method",
+			map[string]string{
+				"CONNECTOR_CREDENTIALS": "gcp_pub_sub_connector_id",
+				"SERVICE_ACCOUNT_KEY":   "gcp_pub_sub_service_account_key",
+			},
+		),
+		CreateContext: resourceWizIntegrationGcpPubSubCreate,
+		ReadContext:   resourceWizIntegrationGcpPubSubRead,
+		UpdateContext: resourceWizIntegrationGcpPubSubUpdate,
+		DeleteContext: resourceWizIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizIntegrationGcpPubSubCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationGcpPubSubCreate called...")
+
+	// define the graphql query
+	query := `mutation CreateIntegration($input: CreateIntegrationInput!) {
+	  createIntegration(
+	    input: $input
+	  ) {
+	    integration {
+	      id
+	    }
+	  }
+	}`
+
+	vars := &wiz.CreateIntegrationInput{}
+	vars.Name = d.Get("name").(string)
+	vars.Type = "GCP_PUB_SUB"
+	vars.ProjectID = d.Get("project_id").(string)
+	vars.IsAccessibleToAllProjects = convertIntegrationScopeToBool(d.Get("scope").(string))
+	vars.Params.GcpPubSub = &wiz.CreateGcpPubSubIntegrationParamsInput{}
+	vars.Params.GcpPubSub.ProjectID = d.Get("gcp_pub_sub_project_id").(string)
+	vars.Params.GcpPubSub.TopicID = d.Get("gcp_pub_sub_topic_id").(string)
+	vars.Params.GcpPubSub.AccessMethod.Type = d.Get("gcp_pub_sub_access_method").(string)
+	vars.Params.GcpPubSub.AccessMethod.AccessConnectorID = d.Get("gcp_pub_sub_connector_id").(string)
+	if serviceAccountKey, ok := d.GetOk("gcp_pub_sub_service_account_key"); ok {
+		vars.Params.GcpPubSub.AccessMethod.ServiceAccountKey = json.RawMessage(serviceAccountKey.(string))
+	}
+
+	// process the request
+	data := &CreateIntegration{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_gcp_pub_sub", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateIntegration.Integration.ID)
+
+	return resourceWizIntegrationGcpPubSubRead(ctx, d, m)
+}
+
+func resourceWizIntegrationGcpPubSubRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationGcpPubSubRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query integration (
+	  $id: ID!
+	) {
+	  integration(
+	    id: $id
+	  ) {
+	    id
+	    name
+	    createdAt
+	    updatedAt
+	    project {
+	      id
+	    }
+	    type
+	    isAccessibleToAllProjects
+	    usedByRules {
+	      id
+	    }
+	    paramsType: params {
+	      type: __typename
+	    }
+	    params {
+	      ... on GcpPubSubIntegrationParams {
+	        projectId
+	        topicId
+	        accessMethod
+	        accessConnector {
+	          id
+	        }
+	      }
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadIntegrationPayload{}
+	params := &wiz.GcpPubSubIntegrationParams{}
+	data.Integration.Params = params
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_gcp_pub_sub", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.Integration.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.Integration.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("created_at", data.Integration.CreatedAt)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("project_id", data.Integration.Project.ID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("gcp_pub_sub_project_id", params.ProjectID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("gcp_pub_sub_topic_id", params.TopicID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("gcp_pub_sub_access_method", params.AccessMethod)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("gcp_pub_sub_connector_id", params.AccessConnector.ID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("gcp_pub_sub_service_account_key", d.Get("gcp_pub_sub_service_account_key").(string))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizIntegrationGcpPubSubUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationGcpPubSubUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateIntegration(
+	  $input: UpdateIntegrationInput!
+	) {
+	  updateIntegration(input: $input) {
+	    integration {
+	      id
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateIntegrationInput{}
+	vars.ID = d.Id()
+	vars.Patch.Name = d.Get("name").(string)
+	vars.Patch.Params.GcpPubSub = &wiz.UpdateGcpPubSubIntegrationParamsInput{}
+	vars.Patch.Params.GcpPubSub.ProjectID = d.Get("gcp_pub_sub_project_id").(string)
+	vars.Patch.Params.GcpPubSub.TopicID = d.Get("gcp_pub_sub_topic_id").(string)
+	vars.Patch.Params.GcpPubSub.AccessMethod.Type = d.Get("gcp_pub_sub_access_method").(string)
+	vars.Patch.Params.GcpPubSub.AccessMethod.AccessConnectorID = d.Get("gcp_pub_sub_connector_id").(string)
+	if serviceAccountKey, ok := d.GetOk("gcp_pub_sub_service_account_key"); ok {
+		vars.Patch.Params.GcpPubSub.AccessMethod.ServiceAccountKey = json.RawMessage(serviceAccountKey.(string))
+	}
+
+	// process the request
+	data := &UpdateIntegration{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_gcp_pub_sub", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
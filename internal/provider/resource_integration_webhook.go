@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizIntegrationWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description: "Webhook integrations send Wiz messages to an HTTP endpoint, such as a SOAR platform, with optional authentication, custom headers and TLS settings.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Identifier for this object.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the integration.",
+				Required:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Identifies the date and time when the object was created.",
+				Computed:    true,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The project this action is scoped to.",
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "All Resources, Restrict this Integration to global roles only",
+				Description: fmt.Sprintf(
+					"Scoping to a selected Project makes this Integration accessible only to users with global roles or Project-scoped access to the selected Project. Other users will not be able to see it, use it, or view its results. Integrations restricted to global roles cannot be seen or used by users with Project-scoped roles. \n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						internal.IntegrationScope,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						internal.IntegrationScope,
+						false,
+					),
+				),
+			},
+			"webhook_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Webhook URL.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsURLWithScheme([]string{"http", "https"}),
+				),
+			},
+			"webhook_is_on_prem": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the webhook endpoint is on prem and reached using a Wiz tunnel.",
+			},
+			"webhook_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Username for basic authorization.",
+				RequiredWith: []string{
+					"webhook_password",
+				},
+				ConflictsWith: []string{
+					"webhook_token",
+				},
+			},
+			"webhook_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password for basic authorization.",
+				RequiredWith: []string{
+					"webhook_username",
+				},
+				ConflictsWith: []string{
+					"webhook_token",
+				},
+			},
+			"webhook_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Token for bearer authorization.",
+			},
+			"webhook_header": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Additional headers to send with each request.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Header name.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Header value.",
+						},
+					},
+				},
+			},
+			"webhook_allow_insecure_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip verification of the webhook server certificate.",
+			},
+			"webhook_server_ca": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate used to verify the webhook server certificate.",
+			},
+			"webhook_client_certificate_and_private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM with the client certificate and private key used for mutual TLS.",
+			},
+		},
+		CreateContext: resourceWizIntegrationWebhookCreate,
+		ReadContext:   resourceWizIntegrationWebhookRead,
+		UpdateContext: resourceWizIntegrationWebhookUpdate,
+		DeleteContext: resourceWizIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWizIntegrationWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationWebhookCreate called...")
+
+	// define the graphql query
+	query := `mutation CreateIntegration($input: CreateIntegrationInput!) {
+	  createIntegration(
+	    input: $input
+	  ) {
+	    integration {
+	      id
+	    }
+	  }
+	}`
+
+	vars := &wiz.CreateIntegrationInput{}
+	vars.Name = d.Get("name").(string)
+	vars.Type = "WEBHOOK"
+	vars.ProjectID = d.Get("project_id").(string)
+	vars.IsAccessibleToAllProjects = convertIntegrationScopeToBool(d.Get("scope").(string))
+	vars.Params.Webhook = &wiz.CreateWebhookIntegrationParamsInput{}
+	vars.Params.Webhook.URL = d.Get("webhook_url").(string)
+	vars.Params.Webhook.IsOnPrem = utils.ConvertBoolToPointer(d.Get("webhook_is_on_prem").(bool))
+	vars.Params.Webhook.Authorization = getIntegrationWebhookAuthorization(ctx, d)
+	vars.Params.Webhook.Headers = getIntegrationWebhookHeaders(ctx, d.Get("webhook_header").([]interface{}))
+	vars.Params.Webhook.TLSConfig.AllowInsecureTLS = utils.ConvertBoolToPointer(d.Get("webhook_allow_insecure_tls").(bool))
+	vars.Params.Webhook.TLSConfig.ServerCA = d.Get("webhook_server_ca").(string)
+	vars.Params.Webhook.TLSConfig.ClientCertificateAndPrivateKey = d.Get("webhook_client_certificate_and_private_key").(string)
+
+	// process the request
+	data := &CreateIntegration{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_webhook", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateIntegration.Integration.ID)
+
+	return resourceWizIntegrationWebhookRead(ctx, d, m)
+}
+
+func resourceWizIntegrationWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationWebhookRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query integration (
+	  $id: ID!
+	) {
+	  integration(
+	    id: $id
+	  ) {
+	    id
+	    name
+	    createdAt
+	    updatedAt
+	    project {
+	      id
+	    }
+	    type
+	    isAccessibleToAllProjects
+	    usedByRules {
+	      id
+	    }
+	    paramsType: params {
+	      type: __typename
+	    }
+	    params {
+	      ... on WebhookIntegrationParams {
+	        url
+	        onPremConfig {
+	          isOnPrem
+	        }
+	        tlsConfig {
+	          allowInsecureTLS
+	          serverCA
+	          clientCertificateAndPrivateKey
+	        }
+	        headers {
+	          key
+	          value
+	        }
+	        authorization {
+	          ... on WebhookIntegrationBasicAuthorization {
+	            username
+	            password
+	          }
+	          ... on WebhookIntegrationBearerAuthorization {
+	            token
+	          }
+	        }
+	      }
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadIntegrationPayload{}
+	params := &wiz.WebhookIntegrationParams{}
+	data.Integration.Params = params
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_webhook", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.Integration.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.Integration.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("created_at", data.Integration.CreatedAt)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("project_id", data.Integration.Project.ID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("webhook_url", params.URL)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("webhook_is_on_prem", params.OnPremConfig.IsOnPrem)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("webhook_allow_insecure_tls", params.TLSConfig.AllowInsecureTLS)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("webhook_server_ca", params.TLSConfig.ServerCA)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("webhook_client_certificate_and_private_key", d.Get("webhook_client_certificate_and_private_key").(string))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if authorization, ok := params.Authorization.(map[string]interface{}); ok {
+		err = d.Set("webhook_username", authorization["username"])
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+	err = d.Set("webhook_password", d.Get("webhook_password").(string))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("webhook_token", d.Get("webhook_token").(string))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("webhook_header", flattenIntegrationWebhookHeaders(ctx, params.Headers))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizIntegrationWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizIntegrationWebhookUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateIntegration(
+	  $input: UpdateIntegrationInput!
+	) {
+	  updateIntegration(input: $input) {
+	    integration {
+	      id
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateIntegrationInput{}
+	vars.ID = d.Id()
+	vars.Patch.Name = d.Get("name").(string)
+	vars.Patch.Params.Webhook = &wiz.UpdateWebhookIntegrationParamsInput{}
+	vars.Patch.Params.Webhook.URL = d.Get("webhook_url").(string)
+	vars.Patch.Params.Webhook.IsOnPrem = utils.ConvertBoolToPointer(d.Get("webhook_is_on_prem").(bool))
+	vars.Patch.Params.Webhook.Authorization = getIntegrationWebhookAuthorization(ctx, d)
+	vars.Patch.Params.Webhook.Headers = getIntegrationWebhookHeaders(ctx, d.Get("webhook_header").([]interface{}))
+	vars.Patch.Params.Webhook.TLSConfig.AllowInsecureTLS = utils.ConvertBoolToPointer(d.Get("webhook_allow_insecure_tls").(bool))
+	vars.Patch.Params.Webhook.TLSConfig.ServerCA = d.Get("webhook_server_ca").(string)
+	vars.Patch.Params.Webhook.TLSConfig.ClientCertificateAndPrivateKey = d.Get("webhook_client_certificate_and_private_key").(string)
+
+	// process the request
+	data := &UpdateIntegration{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_webhook", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
+
+// getIntegrationWebhookAuthorization returns the basic or bearer authorization for the webhook, or nil when neither is set
+func getIntegrationWebhookAuthorization(ctx context.Context, d *schema.ResourceData) *wiz.WebhookIntegrationAuthorizationInput {
+	tflog.Info(ctx, "getIntegrationWebhookAuthorization called...")
+
+	switch {
+	case d.Get("webhook_username").(string) != "":
+		return &wiz.WebhookIntegrationAuthorizationInput{
+			Username: d.Get("webhook_username").(string),
+			Password: d.Get("webhook_password").(string),
+		}
+	case d.Get("webhook_token").(string) != "":
+		return &wiz.WebhookIntegrationAuthorizationInput{
+			Token: d.Get("webhook_token").(string),
+		}
+	}
+
+	return nil
+}
+
+// getIntegrationWebhookHeaders converts header blocks to a slice of wiz.WebhookHeaderInput
+func getIntegrationWebhookHeaders(ctx context.Context, set []interface{}) []wiz.WebhookHeaderInput {
+	tflog.Info(ctx, "getIntegrationWebhookHeaders called...")
+
+	headers := []wiz.WebhookHeaderInput{}
+	for _, h := range set {
+		header := h.(map[string]interface{})
+		headers = append(headers, wiz.WebhookHeaderInput{
+			Key:   header["key"].(string),
+			Value: header["value"].(string),
+		})
+	}
+
+	return headers
+}
+
+// flattenIntegrationWebhookHeaders converts a slice of wiz.WebhookHeader to header blocks
+func flattenIntegrationWebhookHeaders(ctx context.Context, headers []wiz.WebhookHeader) []interface{} {
+	tflog.Info(ctx, "flattenIntegrationWebhookHeaders called...")
+
+	var output = make([]interface{}, 0, 0)
+	for _, h := range headers {
+		output = append(output, map[string]interface{}{
+			"key":   h.Key,
+			"value": h.Value,
+		})
+	}
+
+	return output
+}
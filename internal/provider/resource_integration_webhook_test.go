@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestGetIntegrationWebhookAuthorization(t *testing.T) {
+	ctx := context.Background()
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizIntegrationWebhook().Schema,
+		map[string]interface{}{
+			"webhook_url":   "https://soar.example.com/hooks/wiz",
+			"webhook_token": "e3c5f8a1",
+		},
+	)
+
+	expected := &wiz.WebhookIntegrationAuthorizationInput{
+		Token: "e3c5f8a1",
+	}
+
+	authorization := getIntegrationWebhookAuthorization(ctx, d)
+
+	if !reflect.DeepEqual(authorization, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			authorization,
+			expected,
+		)
+	}
+
+	d = schema.TestResourceDataRaw(
+		t,
+		resourceWizIntegrationWebhook().Schema,
+		map[string]interface{}{
+			"webhook_url": "https://soar.example.com/hooks/wiz",
+		},
+	)
+
+	authorization = getIntegrationWebhookAuthorization(ctx, d)
+
+	if authorization != nil {
+		t.Fatalf("Expected no authorization, got %#v", authorization)
+	}
+}
+
+func TestGetIntegrationWebhookHeaders(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []wiz.WebhookHeaderInput{
+		{
+			Key:   "X-Source",
+			Value: "wiz",
+		},
+		{
+			Key:   "X-Tenant",
+			Value: "security",
+		},
+	}
+
+	headers := getIntegrationWebhookHeaders(
+		ctx,
+		[]interface{}{
+			map[string]interface{}{
+				"key":   "X-Source",
+				"value": "wiz",
+			},
+			map[string]interface{}{
+				"key":   "X-Tenant",
+				"value": "security",
+			},
+		},
+	)
+
+	if !reflect.DeepEqual(headers, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			headers,
+			expected,
+		)
+	}
+}
@@ -0,0 +1,908 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// CreateOutpost struct
+type CreateOutpost struct {
+	CreateOutpost wiz.CreateOutpostPayload `json:"createOutpost"`
+}
+
+// ReadOutpostPayload struct
+type ReadOutpostPayload struct {
+	Outpost wiz.Outpost `json:"outpost"`
+}
+
+// UpdateOutpost struct
+type UpdateOutpost struct {
+	UpdateOutpost wiz.UpdateOutpostPayload `json:"updateOutpost"`
+}
+
+// DeleteOutpost struct
+type DeleteOutpost struct {
+	DeleteOutpost wiz.DeleteOutpostPayload `json:"deleteOutpost"`
+}
+
+const (
+	// outpostStatusConnected is the status of a healthy outpost
+	outpostStatusConnected = "CONNECTED"
+	// outpostStatusError is the status of an outpost that failed to deploy
+	outpostStatusError = "ERROR"
+	// outpostStatusDisabled is the status of a disabled outpost, it stays disabled until enabled again
+	outpostStatusDisabled = "DISABLED"
+	// outpostClusterStatusReady is the status of a cluster ready to run the outpost workloads
+	outpostClusterStatusReady = "READY"
+	// outpostClusterStatusError is the status of a cluster that failed to deploy
+	outpostClusterStatusError = "ERROR"
+	// outpostReadinessPending is the refresh state of an outpost that is not ready yet
+	outpostReadinessPending = "PENDING"
+	// outpostReadinessReady is the refresh state of a connected outpost with all its clusters ready
+	outpostReadinessReady = "READY"
+	// outpostReadinessClusterError is the refresh state of a connected outpost with a cluster that failed to deploy
+	outpostReadinessClusterError = "CLUSTER_ERROR"
+	// outpostStatusPollInterval is the interval between outpost status checks
+	outpostStatusPollInterval = 30 * time.Second
+)
+
+// outpostSchema returns the attributes shared by the outposts merged with the cloud specific attributes
+func outpostSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	outpostSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Wiz identifier for the Outpost.",
+			Computed:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the Outpost.",
+			Required:    true,
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Description: "Whether to enable the Outpost.",
+			Optional:    true,
+			Default:     true,
+		},
+		"self_managed": {
+			Type:        schema.TypeBool,
+			Description: "Whether to enable self managed Outpost.",
+			Optional:    true,
+			Default:     false,
+		},
+		"manual_network_management": {
+			Type:        schema.TypeBool,
+			Description: "Whether to enable manual network configuration.",
+			Optional:    true,
+			Default:     false,
+		},
+		"kubernetes_logging_enabled": {
+			Type:        schema.TypeBool,
+			Description: "Whether to enable Kubernetes Logging.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"kubernetes_cloud_monitoring_enabled": {
+			Type:        schema.TypeBool,
+			Description: "Whether to enable Kubernetes Cloud Monitoring.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"allowed_regions": {
+			Type:        schema.TypeList,
+			Description: "List of allowed regions for the Outpost.",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"wait_for_ready": {
+			Type:        schema.TypeBool,
+			Description: "Wait after creating or updating an enabled Outpost until it is `CONNECTED` and all its clusters are ready, bounded by the `create` and `update` timeouts. An Outpost entering the `ERROR` or `DISABLED` status, or a cluster entering the `ERROR` status, fails the apply.",
+			Optional:    true,
+			Default:     false,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The Outpost status.",
+			Computed:    true,
+		},
+		"error_code": {
+			Type:        schema.TypeString,
+			Description: "The error code reported when the Outpost is in the `ERROR` status.",
+			Computed:    true,
+		},
+		"clusters": {
+			Type:        schema.TypeList,
+			Description: "The clusters of the Outpost.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Description: "Wiz identifier for the Outpost cluster.",
+						Computed:    true,
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Description: "The region the cluster is deployed to.",
+						Computed:    true,
+					},
+					"cluster_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the Kubernetes cluster.",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "The cluster status.",
+						Computed:    true,
+					},
+					"ready": {
+						Type:        schema.TypeBool,
+						Description: "Whether the cluster is ready to run the Outpost workloads.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"custom_config": {
+			Type:        schema.TypeList,
+			Description: "Customizations applied to the Kubernetes resources deployed by the Outpost.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"pod_annotations": {
+						Type:        schema.TypeMap,
+						Description: "Annotations added to the Outpost pods.",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"resource_tags": {
+						Type:        schema.TypeMap,
+						Description: "Tags added to the cloud resources created by the Outpost.",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"namespace_prefix": {
+						Type:        schema.TypeString,
+						Description: "Prefix for the Kubernetes namespaces created by the Outpost.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"self_managed_config": {
+			Type:        schema.TypeList,
+			Description: "Configuration of a self managed Outpost, requires `self_managed` to be `true`.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"image_repository": {
+						Type:        schema.TypeString,
+						Description: "The container image repository the Outpost images are pulled from.",
+						Optional:    true,
+					},
+					"version_id": {
+						Type:        schema.TypeString,
+						Description: "The Outpost version to deploy, defaults to the latest version.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"disable_automatic_configuration_bucket_sync": {
+						Type:        schema.TypeBool,
+						Description: "Whether to disable the automatic synchronization of the configuration bucket.",
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+	}
+
+	for name, attribute := range attributes {
+		outpostSchema[name] = attribute
+	}
+
+	return outpostSchema
+}
+
+// createOutpostFromConfig creates an outpost with the cloud specific configuration and sets the resource id
+func createOutpostFromConfig(ctx context.Context, d *schema.ResourceData, m interface{}, serviceType string, config wiz.OutpostConfigInput) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "createOutpostFromConfig called...")
+
+	// define the graphql query
+	query := `mutation CreateOutpost($input: CreateOutpostInput!) {
+    createOutpost(input: $input) {
+      outpost {
+        id
+      }
+    }
+  }`
+
+	// populate the graphql variables
+	vars := &wiz.CreateOutpostInput{}
+	vars.Name = d.Get("name").(string)
+	vars.ServiceType = serviceType
+	enabled := d.Get("enabled").(bool)
+	vars.Enabled = &enabled
+	selfManaged := d.Get("self_managed").(bool)
+	vars.SelfManaged = &selfManaged
+	vars.Config = config
+	manualNetwork := d.Get("manual_network_management").(bool)
+	vars.ManagedConfig.ManualNetwork = &manualNetwork
+	// the Kubernetes logging and monitoring settings are left to the Wiz defaults unless configured
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.GetAttr("kubernetes_logging_enabled").IsNull() {
+		vars.ManagedConfig.KubernetesLoggingEnabled = utils.ConvertBoolToPointer(d.Get("kubernetes_logging_enabled").(bool))
+	}
+	if !rawConfig.GetAttr("kubernetes_cloud_monitoring_enabled").IsNull() {
+		vars.ManagedConfig.KubernetesCloudMonitoringEnabled = utils.ConvertBoolToPointer(d.Get("kubernetes_cloud_monitoring_enabled").(bool))
+	}
+	vars.AllowedRegions = utils.ConvertListToString(d.Get("allowed_regions").([]interface{}))
+	vars.CustomConfig = expandOutpostCustomConfig(d)
+	vars.SelfManagedConfig = expandOutpostSelfManagedConfig(d)
+
+	// process the request
+	data := &CreateOutpost{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateOutpost.Outpost.ID)
+
+	return diags
+}
+
+// readOutpostFromConfig reads an outpost and sets the attributes from outpostSchema, a nil payload is returned if the outpost no longer exists
+func readOutpostFromConfig(ctx context.Context, d *schema.ResourceData, m interface{}) (*ReadOutpostPayload, diag.Diagnostics) {
+	tflog.Info(ctx, "readOutpostFromConfig called...")
+
+	var diags diag.Diagnostics
+
+	// define the graphql query
+	query := `query OutpostDetails($id: ID!) {
+      outpost(id: $id) {
+        id
+        name
+        enabled
+        serviceType
+        allowedRegions
+        selfManaged
+        externalInternetAccess
+        selfManagedConfig {
+          disableAutomaticConfigurationBucketSync
+          imageRepository
+          version {
+            id
+            images {
+              url
+            }
+          }
+        }
+        managedConfig {
+          kubernetesLoggingEnabled
+          manualNetwork
+          kubernetesCloudMonitoringEnabled
+        }
+        externalInternetAccess
+        status
+        errorCode
+        createdAt
+        addedBy {
+          id
+          name
+          email
+        }
+        clusters {
+          ...OutpostClusterDetails
+        }
+        customConfig {
+          podAnnotations
+          resourceTags
+          namespacePrefix
+        }
+        config {
+          ... on OutpostAWSConfig {
+            roleARN
+            externalID
+            stateBucketName
+            settingsRegion
+            accessKey
+            secretKey
+            disableNatGateway
+            resultsBucketName
+            subscriptionID
+          }
+          ... on OutpostGCPConfig {
+            orchestratorKey
+            workerAccountEmail
+            stateBucketName
+            disableNatGateway
+          }
+          ... on OutpostAzureConfig {
+            tenantID
+            subscriptionID
+            keyVaultName
+            applicationKeyVaultName
+            orchestratorClientID
+            orchestratorClientSecret
+            workerClientID
+            workerClientSecret
+            scannerAppID
+            deployPremiumServiceBus
+            enablePrivateCluster
+            environment
+            stateStorageAccountName
+            globalResourceGroupName
+          }
+          ... on OutpostOCIConfig {
+            compartmentOCID
+            vaultOCID
+            keyOCID
+            stateBucketName
+            orchestrator {
+              fingerprint
+              privateKey
+            }
+          }
+          ... on OutpostAlibabaConfig {
+            workerResourcesGroupID
+            stateBucketName
+            outpostCredentials {
+              orchestratorAccessKeyID
+              orchestratorAccessKeySecret
+            }
+          }
+        }
+      }
+    }
+
+        fragment OutpostClusterDetails on OutpostCluster {
+      id
+      region
+      status
+      createdAt
+      httpProxyConfig {
+        httpProxyURL
+        httpsProxyURL
+        vpcCIDRs
+      }
+      nodeGroups {
+        nodeGroupId
+        type
+        maxNodeCount
+        minNodeCount
+      }
+      config {
+        ... on OutpostClusterAWSConfig {
+          clusterName
+          sqsURL
+          kubernetesServiceAccountName
+        }
+        ... on OutpostClusterAzureConfig {
+          clusterName
+          servicebusQueueName
+          servicebusNamespace
+          resourceGroupName
+          storageAccountNames
+          subscriptionId
+          serviceAuthorizedIPRanges
+        }
+        ... on OutpostClusterGCPConfig {
+          clusterName
+          projectId
+          clusterZone
+          topicName
+          pubSubSubscription
+        }
+        ... on OutpostClusterOCIConfig {
+          clusterName
+          streamOCID
+        }
+        ... on OutpostClusterAlibabaConfig {
+          clusterName
+          queueName
+        }
+      }
+      addedBy {
+        id
+        name
+        email
+      }
+    }`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadOutpostPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+		// we are checking if the response does not have an ID and if so we mark the resource as new
+		if data.Outpost.ID == "" {
+			tflog.Info(ctx, "resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil, nil
+		}
+		return nil, diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.Outpost.Name)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("enabled", data.Outpost.Enabled)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("self_managed", data.Outpost.SelfManaged)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("manual_network_management", data.Outpost.ManagedConfig.ManualNetwork)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("kubernetes_logging_enabled", data.Outpost.ManagedConfig.KubernetesLoggingEnabled)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("kubernetes_cloud_monitoring_enabled", data.Outpost.ManagedConfig.KubernetesCloudMonitoringEnabled)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("status", data.Outpost.Status)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("error_code", data.Outpost.ErrorCode)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("clusters", flattenOutpostClusters(data.Outpost.Clusters))
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("custom_config", flattenOutpostCustomConfig(data.Outpost.CustomConfig))
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("self_managed_config", flattenOutpostSelfManagedConfig(data.Outpost.SelfManagedConfig))
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	return data, diags
+}
+
+// updateOutpostFromPatch updates an outpost, the shared attributes are added to the cloud specific patch
+func updateOutpostFromPatch(ctx context.Context, d *schema.ResourceData, m interface{}, patch wiz.UpdateOutpostPatch) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "updateOutpostFromPatch called...")
+
+	// define the graphql query
+	query := `mutation UpdateOutpost($input: UpdateOutpostInput!) {
+      updateOutpost(input: $input) {
+        outpost {
+          id
+          name
+          enabled
+          status
+          errorCode
+          managedConfig {
+            kubernetesLoggingEnabled
+            manualNetwork
+          }
+          selfManagedConfig {
+            disableAutomaticConfigurationBucketSync
+            version {
+              id
+              images {
+                url
+              }
+            }
+          }
+          customConfig {
+            podAnnotations
+            resourceTags
+            namespacePrefix
+          }
+          config {
+            ... on OutpostAWSConfig {
+              roleARN
+              externalID
+              stateBucketName
+              settingsRegion
+              accessKey
+              secretKey
+              disableNatGateway
+              subscriptionID
+            }
+            ... on OutpostGCPConfig {
+              orchestratorKey
+              workerAccountEmail
+              stateBucketName
+              disableNatGateway
+            }
+            ... on OutpostAzureConfig {
+              tenantID
+              subscriptionID
+              keyVaultName
+              applicationKeyVaultName
+              orchestratorClientID
+              orchestratorClientSecret
+              workerClientID
+              workerClientSecret
+              scannerAppID
+              environment
+              stateStorageAccountName
+              globalResourceGroupName
+              deployPremiumServiceBus
+              enablePrivateCluster
+            }
+            ... on OutpostOCIConfig {
+              compartmentOCID
+              vaultOCID
+              keyOCID
+              stateBucketName
+            }
+            ... on OutpostAlibabaConfig {
+              stateBucketName
+            }
+          }
+        }
+      }
+    }`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateOutpostInput{}
+	vars.ID = d.Id()
+	vars.Patch = patch
+
+	// these can optionally be included in the patch
+	if d.HasChange("enabled") {
+		vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+	}
+	if d.HasChange("name") {
+		vars.Patch.Name = d.Get("name").(string)
+	}
+	if d.HasChange("allowed_regions") {
+		vars.Patch.AllowedRegions = utils.ConvertListToString(d.Get("allowed_regions").([]interface{}))
+	}
+	if d.HasChanges("manual_network_management", "kubernetes_logging_enabled", "kubernetes_cloud_monitoring_enabled") {
+		vars.Patch.ManagedConfig = &wiz.OutpostManagedConfigInput{
+			ManualNetwork:                    utils.ConvertBoolToPointer(d.Get("manual_network_management").(bool)),
+			KubernetesLoggingEnabled:         utils.ConvertBoolToPointer(d.Get("kubernetes_logging_enabled").(bool)),
+			KubernetesCloudMonitoringEnabled: utils.ConvertBoolToPointer(d.Get("kubernetes_cloud_monitoring_enabled").(bool)),
+		}
+	}
+	if d.HasChange("custom_config") {
+		// an empty custom configuration clears the customizations
+		vars.Patch.CustomConfig = expandOutpostCustomConfig(d)
+		if vars.Patch.CustomConfig == nil {
+			vars.Patch.CustomConfig = &wiz.OutpostCustomConfig{}
+		}
+	}
+	if d.HasChange("self_managed_config") {
+		// an empty self managed configuration clears the image repository and version and restores the bucket sync
+		vars.Patch.SelfManagedConfig = expandOutpostSelfManagedConfig(d)
+		if vars.Patch.SelfManagedConfig == nil {
+			vars.Patch.SelfManagedConfig = &wiz.OutpostSelfManagedConfigInput{
+				DisableAutomaticConfigurationBucketSync: utils.ConvertBoolToPointer(false),
+			}
+		}
+	}
+
+	// skip the request when only local attributes such as `wait_for_ready` changed
+	if reflect.DeepEqual(vars.Patch, wiz.UpdateOutpostPatch{}) {
+		return diags
+	}
+
+	// process the request
+	data := &UpdateOutpost{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
+
+// outpostCustomizeDiff ensures the self managed configuration is only set for self managed outposts
+func outpostCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if len(d.Get("self_managed_config").([]interface{})) > 0 && !d.Get("self_managed").(bool) {
+		return fmt.Errorf("self_managed_config requires self_managed to be true")
+	}
+	return nil
+}
+
+// expandOutpostCustomConfig builds the custom configuration from the `custom_config` block, nil is returned when the block is not set
+func expandOutpostCustomConfig(d *schema.ResourceData) *wiz.OutpostCustomConfig {
+	customConfigs := d.Get("custom_config").([]interface{})
+	if len(customConfigs) == 0 || customConfigs[0] == nil {
+		return nil
+	}
+	customConfig := customConfigs[0].(map[string]interface{})
+
+	return &wiz.OutpostCustomConfig{
+		PodAnnotations:  utils.ConvertMapToStringMap(customConfig["pod_annotations"].(map[string]interface{})),
+		ResourceTags:    utils.ConvertMapToStringMap(customConfig["resource_tags"].(map[string]interface{})),
+		NamespacePrefix: customConfig["namespace_prefix"].(string),
+	}
+}
+
+// flattenOutpostCustomConfig returns the `custom_config` block, the block is empty when the outpost has no customizations
+func flattenOutpostCustomConfig(customConfig wiz.OutpostCustomConfig) []interface{} {
+	if len(customConfig.PodAnnotations) == 0 && len(customConfig.ResourceTags) == 0 && customConfig.NamespacePrefix == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"pod_annotations":  customConfig.PodAnnotations,
+			"resource_tags":    customConfig.ResourceTags,
+			"namespace_prefix": customConfig.NamespacePrefix,
+		},
+	}
+}
+
+// expandOutpostSelfManagedConfig builds the self managed configuration from the `self_managed_config` block, nil is returned when the block is not set
+func expandOutpostSelfManagedConfig(d *schema.ResourceData) *wiz.OutpostSelfManagedConfigInput {
+	selfManagedConfigs := d.Get("self_managed_config").([]interface{})
+	if len(selfManagedConfigs) == 0 || selfManagedConfigs[0] == nil {
+		return nil
+	}
+	selfManagedConfig := selfManagedConfigs[0].(map[string]interface{})
+
+	return &wiz.OutpostSelfManagedConfigInput{
+		ImageRepository:                         selfManagedConfig["image_repository"].(string),
+		VersionID:                               selfManagedConfig["version_id"].(string),
+		DisableAutomaticConfigurationBucketSync: utils.ConvertBoolToPointer(selfManagedConfig["disable_automatic_configuration_bucket_sync"].(bool)),
+	}
+}
+
+// flattenOutpostSelfManagedConfig returns the `self_managed_config` block, the block is empty for managed outposts
+func flattenOutpostSelfManagedConfig(selfManagedConfig *wiz.OutpostSelfManagedConfig) []interface{} {
+	if selfManagedConfig == nil {
+		return []interface{}{}
+	}
+
+	versionID := ""
+	if selfManagedConfig.Version != nil {
+		versionID = selfManagedConfig.Version.ID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"image_repository": selfManagedConfig.ImageRepository,
+			"version_id":       versionID,
+			"disable_automatic_configuration_bucket_sync": selfManagedConfig.DisableAutomaticConfigurationBucketSync,
+		},
+	}
+}
+
+// flattenOutpostClusters returns the `clusters` attribute
+func flattenOutpostClusters(clusters []wiz.OutpostCluster) []interface{} {
+	var output = make([]interface{}, 0)
+	for _, cluster := range clusters {
+		output = append(output, map[string]interface{}{
+			"id":           cluster.ID,
+			"region":       cluster.Region,
+			"cluster_name": cluster.Config.ClusterName,
+			"status":       cluster.Status,
+			"ready":        cluster.Status == outpostClusterStatusReady,
+		})
+	}
+
+	return output
+}
+
+// outpostReadiness returns the refresh state of an outpost, an outpost is ready once connected with all its clusters ready.
+// the error and disabled statuses are returned as is so the wait stops instead of running until the timeout.
+func outpostReadiness(outpost wiz.Outpost) string {
+	switch outpost.Status {
+	case outpostStatusConnected:
+		readiness := outpostReadinessReady
+		for _, cluster := range outpost.Clusters {
+			switch cluster.Status {
+			case outpostClusterStatusReady:
+			case outpostClusterStatusError:
+				return outpostReadinessClusterError
+			default:
+				readiness = outpostReadinessPending
+			}
+		}
+		return readiness
+	case outpostStatusError, outpostStatusDisabled:
+		return outpost.Status
+	default:
+		return outpostReadinessPending
+	}
+}
+
+// outpostErrorDiagnostic describes an outpost that failed to deploy
+func outpostErrorDiagnostic(outpost wiz.Outpost) diag.Diagnostic {
+	errorCode := outpost.ErrorCode
+	if errorCode == "" {
+		errorCode = "unknown"
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Outpost %s failed to deploy.", outpost.Name),
+		Detail:   fmt.Sprintf("The Outpost %s is in the %s status with error code %s. Review the Outpost in the Wiz portal and the permissions granted to the orchestrator.", outpost.ID, outpost.Status, errorCode),
+	}
+}
+
+// outpostClusterErrorDiagnostic describes a connected outpost with clusters that failed to deploy
+func outpostClusterErrorDiagnostic(outpost wiz.Outpost) diag.Diagnostic {
+	var clusters []string
+	for _, cluster := range outpost.Clusters {
+		if cluster.Status == outpostClusterStatusError {
+			clusters = append(clusters, fmt.Sprintf("%s (%s)", cluster.ID, cluster.Region))
+		}
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Outpost %s clusters failed to deploy.", outpost.Name),
+		Detail:   fmt.Sprintf("The Outpost %s is connected but the clusters %s are in the %s status. Review the Outpost clusters in the Wiz portal.", outpost.ID, strings.Join(clusters, ", "), outpostClusterStatusError),
+	}
+}
+
+// outpostStatusRefreshFunc polls the readiness of an outpost
+func outpostStatusRefreshFunc(ctx context.Context, m interface{}, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		tflog.Info(ctx, "outpostStatusRefreshFunc called...")
+
+		// define the graphql query
+		query := `query GetOutpostStatus($id: ID!) {
+		    outpost(id: $id) {
+		      id
+		      name
+		      status
+		      errorCode
+		      clusters {
+		        id
+		        region
+		        status
+		      }
+		    }
+		  }`
+
+		// populate the graphql variables
+		vars := &internal.QueryVariables{}
+		vars.ID = id
+
+		// process the request
+		data := &ReadOutpostPayload{}
+		requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost", "read")
+		if requestDiags.HasError() {
+			var errs []string
+			for _, requestDiag := range requestDiags {
+				errs = append(errs, strings.TrimSpace(fmt.Sprintf("%s %s", requestDiag.Summary, requestDiag.Detail)))
+			}
+			return nil, "", fmt.Errorf("unable to read outpost status: %s", strings.Join(errs, "; "))
+		}
+
+		readiness := outpostReadiness(data.Outpost)
+		tflog.Debug(ctx, fmt.Sprintf("outpost %s status: %s, readiness: %s", id, data.Outpost.Status, readiness))
+
+		return data.Outpost, readiness, nil
+	}
+}
+
+// waitForOutpostReady waits for an enabled outpost to be connected with all its clusters ready when `wait_for_ready` is set
+func waitForOutpostReady(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "waitForOutpostReady called...")
+
+	if !d.Get("wait_for_ready").(bool) || !d.Get("enabled").(bool) {
+		return nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:      []string{outpostReadinessPending},
+		Target:       []string{outpostReadinessReady},
+		Refresh:      outpostStatusRefreshFunc(ctx, m, d.Id()),
+		Timeout:      timeout,
+		PollInterval: outpostStatusPollInterval,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if outpost, ok := result.(wiz.Outpost); ok {
+			switch outpost.Status {
+			case outpostStatusError:
+				return append(diags, outpostErrorDiagnostic(outpost))
+			case outpostStatusDisabled:
+				return append(diags, diag.Errorf("outpost %s was disabled while waiting for it to be ready", d.Id())...)
+			}
+			if outpostReadiness(outpost) == outpostReadinessClusterError {
+				return append(diags, outpostClusterErrorDiagnostic(outpost))
+			}
+		}
+		return append(diags, diag.Errorf("error waiting for outpost %s to be ready: %v", d.Id(), err)...)
+	}
+
+	return diags
+}
+
+// appendOutpostReadDiags appends the diagnostics of the read following a wait, the read refreshes the state even when the wait failed
+func appendOutpostReadDiags(waitDiags diag.Diagnostics, readDiags diag.Diagnostics) diag.Diagnostics {
+	return append(waitDiags, readDiags...)
+}
+
+// resourceWizOutpostDelete uninstalls a Wiz outpost
+func resourceWizOutpostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UninstallOutpost($input: UninstallOutpostInput!) {
+      uninstallOutpost(input: $input) {
+        outpost {
+          id
+          status
+        }
+      }
+    }`
+
+	// populate the graphql variables
+	vars := &wiz.DeleteOutpostInput{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &DeleteOutpost{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost", "delete")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Diags Count: %d", len(diags)))
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizOutpostAzure() *schema.Resource {
+	return &schema.Resource{
+		Description: "This resource allows you to create, read, update, and delete a Wiz Outpost deployed in Azure.",
+		Schema: outpostSchema(map[string]*schema.Schema{
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Description: "The Azure tenant ID.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the Azure subscription the Outpost is deployed to.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Description: "The Azure cloud environment, e.g. `AzureCloud`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"key_vault_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the key vault holding the Outpost encryption keys.",
+				Required:    true,
+			},
+			"application_key_vault_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the key vault holding the Outpost application secrets.",
+				Required:    true,
+			},
+			"orchestrator_client_id": {
+				Type:        schema.TypeString,
+				Description: "The client ID of the orchestrator application used to setup and monitor the Outpost deployment.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"orchestrator_client_secret": {
+				Type:        schema.TypeString,
+				Description: "The client secret of the orchestrator application.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"worker_client_id": {
+				Type:        schema.TypeString,
+				Description: "The client ID of the worker application used by the Outpost clusters.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"worker_client_secret": {
+				Type:        schema.TypeString,
+				Description: "The client secret of the worker application.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"scanner_app_id": {
+				Type:        schema.TypeString,
+				Description: "The application ID of the disk scanner.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"configuration_storage_account_name": {
+				Type:        schema.TypeString,
+				Description: "The Configuration Storage Account is used to configure the AKS Clusters running as part of the Outpost Connector.",
+				Required:    true,
+			},
+			"global_resource_group_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the resource group holding the global Outpost resources.",
+				Required:    true,
+			},
+			"deploy_premium_service_bus": {
+				Type:        schema.TypeBool,
+				Description: "Whether to deploy a premium tier Service Bus.",
+				Optional:    true,
+				Default:     false,
+			},
+			"enable_private_cluster": {
+				Type:        schema.TypeBool,
+				Description: "Whether to deploy the Outpost clusters as private AKS clusters.",
+				Optional:    true,
+				Default:     false,
+			},
+		}),
+		CustomizeDiff: outpostCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CreateContext: resourceWizOutpostAzureCreate,
+		ReadContext:   resourceWizOutpostAzureRead,
+		UpdateContext: resourceWizOutpostAzureUpdate,
+		DeleteContext: resourceWizOutpostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// expandOutpostAzureConfig builds the Azure outpost configuration
+func expandOutpostAzureConfig(d *schema.ResourceData) wiz.OutpostConfigInput {
+	return wiz.OutpostConfigInput{
+		AzureConfig: &wiz.OutpostAzureConfigInput{
+			TenantID:                 d.Get("tenant_id").(string),
+			SubscriptionID:           d.Get("subscription_id").(string),
+			Environment:              d.Get("environment").(string),
+			KeyVaultName:             d.Get("key_vault_name").(string),
+			ApplicationKeyVaultName:  d.Get("application_key_vault_name").(string),
+			OrchestratorClientID:     d.Get("orchestrator_client_id").(string),
+			OrchestratorClientSecret: d.Get("orchestrator_client_secret").(string),
+			WorkerClientID:           d.Get("worker_client_id").(string),
+			WorkerClientSecret:       d.Get("worker_client_secret").(string),
+			ScannerAppID:             d.Get("scanner_app_id").(string),
+			StateStorageAccountName:  d.Get("configuration_storage_account_name").(string),
+			GlobalResourceGroupName:  d.Get("global_resource_group_name").(string),
+			DeployPremiumServiceBus:  utils.ConvertBoolToPointer(d.Get("deploy_premium_service_bus").(bool)),
+			EnablePrivateCluster:     utils.ConvertBoolToPointer(d.Get("enable_private_cluster").(bool)),
+		},
+	}
+}
+
+func resourceWizOutpostAzureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostAzureCreate called...")
+
+	diags = createOutpostFromConfig(ctx, d, m, "Azure", expandOutpostAzureConfig(d))
+	if len(diags) > 0 {
+		return diags
+	}
+
+	diags = waitForOutpostReady(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+
+	return appendOutpostReadDiags(diags, resourceWizOutpostAzureRead(ctx, d, m))
+}
+
+func resourceWizOutpostAzureRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostAzureRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readOutpostFromConfig(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	// the client secrets are not returned by Wiz and keep their state
+	diags = append(diags, setConnectorAuthAttributes(d, map[string]string{
+		"tenant_id":                          data.Outpost.Config.TenantID,
+		"subscription_id":                    data.Outpost.Config.SubscriptionID,
+		"environment":                        data.Outpost.Config.Environment,
+		"key_vault_name":                     data.Outpost.Config.KeyVaultName,
+		"application_key_vault_name":         data.Outpost.Config.ApplicationKeyVaultName,
+		"orchestrator_client_id":             data.Outpost.Config.OrchestratorClientID,
+		"worker_client_id":                   data.Outpost.Config.WorkerClientID,
+		"scanner_app_id":                     data.Outpost.Config.ScannerAppID,
+		"configuration_storage_account_name": data.Outpost.Config.StateStorageAccountName,
+		"global_resource_group_name":         data.Outpost.Config.GlobalResourceGroupName,
+	})...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	err := d.Set("deploy_premium_service_bus", data.Outpost.Config.DeployPremiumServiceBus)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("enable_private_cluster", data.Outpost.Config.EnablePrivateCluster)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizOutpostAzureUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostAzureUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// the configuration is patched as a whole when any of its attributes change
+	patch := wiz.UpdateOutpostPatch{}
+	if d.HasChanges(
+		"tenant_id",
+		"subscription_id",
+		"environment",
+		"key_vault_name",
+		"application_key_vault_name",
+		"orchestrator_client_id",
+		"orchestrator_client_secret",
+		"worker_client_id",
+		"worker_client_secret",
+		"scanner_app_id",
+		"configuration_storage_account_name",
+		"global_resource_group_name",
+		"deploy_premium_service_bus",
+		"enable_private_cluster",
+	) {
+		config := expandOutpostAzureConfig(d)
+		patch.Config = &config
+	}
+
+	diags = updateOutpostFromPatch(ctx, d, m, patch)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	diags = waitForOutpostReady(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+
+	return appendOutpostReadDiags(diags, resourceWizOutpostAzureRead(ctx, d, m))
+}
@@ -0,0 +1,445 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// CreateOutpostCluster struct
+type CreateOutpostCluster struct {
+	CreateOutpostCluster wiz.CreateOutpostClusterPayload `json:"createOutpostCluster"`
+}
+
+// UpdateOutpostCluster struct
+type UpdateOutpostCluster struct {
+	UpdateOutpostCluster wiz.UpdateOutpostClusterPayload `json:"updateOutpostCluster"`
+}
+
+// DeleteOutpostCluster struct
+type DeleteOutpostCluster struct {
+	DeleteOutpostCluster wiz.DeleteOutpostClusterPayload `json:"deleteOutpostCluster"`
+}
+
+func resourceWizOutpostCluster() *schema.Resource {
+	return &schema.Resource{
+		Description: "Outpost clusters run the Outpost workloads in a region of the Outpost cloud account.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Wiz identifier for the Outpost cluster.",
+				Computed:    true,
+			},
+			"outpost_id": {
+				Type:        schema.TypeString,
+				Description: "The identifier of the Outpost the cluster belongs to.",
+				Required:    true,
+				ForceNew:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "The region the cluster is deployed to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Kubernetes cluster.",
+				Computed:    true,
+			},
+			"node_group": {
+				Type:        schema.TypeList,
+				Description: "The node groups of the cluster. When not set, the Wiz default node groups are used.",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_group_id": {
+							Type:        schema.TypeString,
+							Description: "Wiz identifier for the node group.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The type of the node group, e.g. the workload it runs.",
+							Required:    true,
+						},
+						"min_node_count": {
+							Type:        schema.TypeInt,
+							Description: "The minimum number of nodes in the node group.",
+							Required:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.IntAtLeast(0),
+							),
+						},
+						"max_node_count": {
+							Type:        schema.TypeInt,
+							Description: "The maximum number of nodes in the node group.",
+							Required:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.IntAtLeast(1),
+							),
+						},
+					},
+				},
+			},
+			"http_proxy": {
+				Type:        schema.TypeList,
+				Description: "The HTTP proxy used by the cluster for outbound traffic.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_proxy_url": {
+							Type:        schema.TypeString,
+							Description: "The proxy URL for HTTP traffic.",
+							Optional:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.IsURLWithHTTPorHTTPS,
+							),
+						},
+						"https_proxy_url": {
+							Type:        schema.TypeString,
+							Description: "The proxy URL for HTTPS traffic.",
+							Optional:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.IsURLWithHTTPorHTTPS,
+							),
+						},
+						"vpc_cidrs": {
+							Type:        schema.TypeList,
+							Description: "The VPC CIDRs that bypass the proxy.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.IsCIDR,
+								),
+							},
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: outpostClusterCustomizeDiff,
+		CreateContext: resourceWizOutpostClusterCreate,
+		ReadContext:   resourceWizOutpostClusterRead,
+		UpdateContext: resourceWizOutpostClusterUpdate,
+		DeleteContext: resourceWizOutpostClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWizOutpostClusterImport,
+		},
+	}
+}
+
+// outpostClusterCustomizeDiff ensures the minimum node count of each node group does not exceed the maximum node count
+func outpostClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for i, nodeGroup := range d.Get("node_group").([]interface{}) {
+		if nodeGroup == nil {
+			continue
+		}
+		nodeGroupMap := nodeGroup.(map[string]interface{})
+		if nodeGroupMap["min_node_count"].(int) > nodeGroupMap["max_node_count"].(int) {
+			return fmt.Errorf("node_group.%d.min_node_count must not exceed node_group.%d.max_node_count", i, i)
+		}
+	}
+	return nil
+}
+
+// resourceWizOutpostClusterImport imports an outpost cluster using the `<outpost_id>:<cluster_id>` format
+func resourceWizOutpostClusterImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <outpost_id>:<cluster_id>", d.Id())
+	}
+
+	err := d.Set("outpost_id", parts[0])
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandOutpostClusterNodeGroups builds the node groups from the `node_group` blocks
+func expandOutpostClusterNodeGroups(d *schema.ResourceData) []wiz.OutpostClusterNodeGroupInput {
+	var nodeGroups []wiz.OutpostClusterNodeGroupInput
+	for _, nodeGroup := range d.Get("node_group").([]interface{}) {
+		if nodeGroup == nil {
+			continue
+		}
+		nodeGroupMap := nodeGroup.(map[string]interface{})
+		nodeGroups = append(nodeGroups, wiz.OutpostClusterNodeGroupInput{
+			NodeGroupID:  nodeGroupMap["node_group_id"].(string),
+			Type:         nodeGroupMap["type"].(string),
+			MinNodeCount: nodeGroupMap["min_node_count"].(int),
+			MaxNodeCount: nodeGroupMap["max_node_count"].(int),
+		})
+	}
+
+	return nodeGroups
+}
+
+// flattenOutpostClusterNodeGroups returns the `node_group` blocks
+func flattenOutpostClusterNodeGroups(nodeGroups []wiz.OutpostClusterNodeGroup) []interface{} {
+	var output = make([]interface{}, 0)
+	for _, nodeGroup := range nodeGroups {
+		output = append(output, map[string]interface{}{
+			"node_group_id":  nodeGroup.NodeGroupID,
+			"type":           nodeGroup.Type,
+			"min_node_count": nodeGroup.MinNodeCount,
+			"max_node_count": nodeGroup.MaxNodeCount,
+		})
+	}
+
+	return output
+}
+
+// expandOutpostClusterHTTPProxy builds the HTTP proxy configuration from the `http_proxy` block, nil is returned when the block is not set
+func expandOutpostClusterHTTPProxy(d *schema.ResourceData) *wiz.OutpostClusterHTTPProxyConfig {
+	httpProxies := d.Get("http_proxy").([]interface{})
+	if len(httpProxies) == 0 || httpProxies[0] == nil {
+		return nil
+	}
+	httpProxy := httpProxies[0].(map[string]interface{})
+
+	return &wiz.OutpostClusterHTTPProxyConfig{
+		HTTPProxyURL:  httpProxy["http_proxy_url"].(string),
+		HTTPSProxyURL: httpProxy["https_proxy_url"].(string),
+		VpcCIDRs:      utils.ConvertListToString(httpProxy["vpc_cidrs"].([]interface{})),
+	}
+}
+
+// flattenOutpostClusterHTTPProxy returns the `http_proxy` block, the block is empty when the cluster does not use a proxy
+func flattenOutpostClusterHTTPProxy(httpProxy *wiz.OutpostClusterHTTPProxyConfig) []interface{} {
+	if httpProxy == nil || (httpProxy.HTTPProxyURL == "" && httpProxy.HTTPSProxyURL == "" && len(httpProxy.VpcCIDRs) == 0) {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"http_proxy_url":  httpProxy.HTTPProxyURL,
+			"https_proxy_url": httpProxy.HTTPSProxyURL,
+			"vpc_cidrs":       utils.ConvertSliceToGenericArray(httpProxy.VpcCIDRs),
+		},
+	}
+}
+
+func resourceWizOutpostClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostClusterCreate called...")
+
+	// define the graphql query
+	query := `mutation CreateOutpostCluster($input: CreateOutpostClusterInput!) {
+      createOutpostCluster(input: $input) {
+        outpostCluster {
+          id
+        }
+      }
+    }`
+
+	// populate the graphql variables
+	vars := &wiz.CreateOutpostClusterInput{}
+	vars.OutpostID = d.Get("outpost_id").(string)
+	vars.Region = d.Get("region").(string)
+	vars.NodeGroups = expandOutpostClusterNodeGroups(d)
+	vars.HTTPProxyConfig = expandOutpostClusterHTTPProxy(d)
+
+	// process the request
+	data := &CreateOutpostCluster{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost_cluster", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateOutpostCluster.OutpostCluster.ID)
+
+	return resourceWizOutpostClusterRead(ctx, d, m)
+}
+
+func resourceWizOutpostClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostClusterRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query OutpostClusters($id: ID!) {
+      outpost(id: $id) {
+        id
+        clusters {
+          id
+          region
+          httpProxyConfig {
+            httpProxyURL
+            httpsProxyURL
+            vpcCIDRs
+          }
+          nodeGroups {
+            nodeGroupId
+            type
+            maxNodeCount
+            minNodeCount
+          }
+          config {
+            ... on OutpostClusterAWSConfig {
+              clusterName
+            }
+            ... on OutpostClusterAzureConfig {
+              clusterName
+            }
+            ... on OutpostClusterGCPConfig {
+              clusterName
+            }
+            ... on OutpostClusterOCIConfig {
+              clusterName
+            }
+            ... on OutpostClusterAlibabaConfig {
+              clusterName
+            }
+          }
+        }
+      }
+    }`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Get("outpost_id").(string)
+
+	// process the request
+	data := &ReadOutpostPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost_cluster", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 && data.Outpost.ID != "" {
+		return diags
+	}
+
+	// the cluster is removed from state when either the outpost or the cluster no longer exists
+	var cluster *wiz.OutpostCluster
+	for i := range data.Outpost.Clusters {
+		if data.Outpost.Clusters[i].ID == d.Id() {
+			cluster = &data.Outpost.Clusters[i]
+			break
+		}
+	}
+	if cluster == nil {
+		tflog.Info(ctx, "resource not found, marking as new.")
+		d.SetId("")
+		d.MarkNewResource()
+		return nil
+	}
+
+	// set the resource parameters
+	err := d.Set("region", cluster.Region)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("cluster_name", cluster.Config.ClusterName)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("node_group", flattenOutpostClusterNodeGroups(cluster.NodeGroups))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("http_proxy", flattenOutpostClusterHTTPProxy(cluster.HTTPProxyConfig))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizOutpostClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostClusterUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateOutpostCluster($input: UpdateOutpostClusterInput!) {
+      updateOutpostCluster(input: $input) {
+        outpostCluster {
+          id
+        }
+      }
+    }`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateOutpostClusterInput{}
+	vars.ID = d.Id()
+
+	// these can optionally be included in the patch
+	if d.HasChange("node_group") {
+		vars.Patch.NodeGroups = expandOutpostClusterNodeGroups(d)
+	}
+	if d.HasChange("http_proxy") {
+		// an empty proxy configuration removes the proxy
+		vars.Patch.HTTPProxyConfig = expandOutpostClusterHTTPProxy(d)
+		if vars.Patch.HTTPProxyConfig == nil {
+			vars.Patch.HTTPProxyConfig = &wiz.OutpostClusterHTTPProxyConfig{}
+		}
+	}
+
+	// process the request
+	data := &UpdateOutpostCluster{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost_cluster", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizOutpostClusterRead(ctx, d, m)
+}
+
+func resourceWizOutpostClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostClusterDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation DeleteOutpostCluster($input: DeleteOutpostClusterInput!) {
+      deleteOutpostCluster(input: $input) {
+        _stub
+      }
+    }`
+
+	// populate the graphql variables
+	vars := &wiz.DeleteOutpostClusterInput{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &DeleteOutpostCluster{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost_cluster", "delete")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestExpandOutpostClusterNodeGroups(t *testing.T) {
+	expected := []wiz.OutpostClusterNodeGroupInput{
+		{
+			Type:         "DISK_SCANNER",
+			MinNodeCount: 1,
+			MaxNodeCount: 10,
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizOutpostCluster().Schema,
+		map[string]interface{}{
+			"outpost_id": "078862d0-a62f-406c-b966-13445af34c0d",
+			"region":     "us-east-1",
+			"node_group": []interface{}{
+				map[string]interface{}{
+					"type":           "DISK_SCANNER",
+					"min_node_count": 1,
+					"max_node_count": 10,
+				},
+			},
+		},
+	)
+
+	nodeGroups := expandOutpostClusterNodeGroups(d)
+
+	if !reflect.DeepEqual(nodeGroups, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			nodeGroups,
+			expected,
+		)
+	}
+}
+
+func TestFlattenOutpostClusterHTTPProxy(t *testing.T) {
+	expected := []interface{}{
+		map[string]interface{}{
+			"http_proxy_url":  "http://proxy.example.com:3128",
+			"https_proxy_url": "http://proxy.example.com:3129",
+			"vpc_cidrs":       []interface{}{"10.0.0.0/16"},
+		},
+	}
+
+	httpProxy := flattenOutpostClusterHTTPProxy(&wiz.OutpostClusterHTTPProxyConfig{
+		HTTPProxyURL:  "http://proxy.example.com:3128",
+		HTTPSProxyURL: "http://proxy.example.com:3129",
+		VpcCIDRs:      []string{"10.0.0.0/16"},
+	})
+
+	if !reflect.DeepEqual(httpProxy, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			httpProxy,
+			expected,
+		)
+	}
+
+	if len(flattenOutpostClusterHTTPProxy(&wiz.OutpostClusterHTTPProxyConfig{})) != 0 {
+		t.Fatal("expected no http_proxy block for a cluster without a proxy")
+	}
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizOutpostGCP() *schema.Resource {
+	return &schema.Resource{
+		Description: "This resource allows you to create, read, update, and delete a Wiz Outpost deployed in GCP.",
+		Schema: outpostSchema(map[string]*schema.Schema{
+			"orchestrator_key": {
+				Type:        schema.TypeString,
+				Description: "The `JSON` key of the orchestrator service account used to setup and monitor the Outpost deployment in the project.",
+				Required:    true,
+				Sensitive:   true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+			"configuration_bucket_name": {
+				Type:        schema.TypeString,
+				Description: "The Configuration Bucket is used to configure the GKE Clusters running as part of the Outpost Connector.",
+				Required:    true,
+			},
+			"disable_nat_gateway": {
+				Type:        schema.TypeBool,
+				Description: "Whether to disable Cloud NAT.",
+				Optional:    true,
+				Default:     false,
+			},
+			"worker_service_account_email": {
+				Type:        schema.TypeString,
+				Description: "The email of the worker service account used by the Outpost clusters.",
+				Computed:    true,
+			},
+		}),
+		CustomizeDiff: outpostCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CreateContext: resourceWizOutpostGCPCreate,
+		ReadContext:   resourceWizOutpostGCPRead,
+		UpdateContext: resourceWizOutpostGCPUpdate,
+		DeleteContext: resourceWizOutpostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// expandOutpostGCPConfig builds the GCP outpost configuration
+func expandOutpostGCPConfig(d *schema.ResourceData) wiz.OutpostConfigInput {
+	disableNatGateway := d.Get("disable_nat_gateway").(bool)
+
+	return wiz.OutpostConfigInput{
+		GcpConfig: &wiz.OutpostGCPConfigInput{
+			OrchestratorKey:   d.Get("orchestrator_key").(string),
+			StateBucketName:   d.Get("configuration_bucket_name").(string),
+			DisableNatGateway: &disableNatGateway,
+		},
+	}
+}
+
+func resourceWizOutpostGCPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostGCPCreate called...")
+
+	diags = createOutpostFromConfig(ctx, d, m, "GCP", expandOutpostGCPConfig(d))
+	if len(diags) > 0 {
+		return diags
+	}
+
+	diags = waitForOutpostReady(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+
+	return appendOutpostReadDiags(diags, resourceWizOutpostGCPRead(ctx, d, m))
+}
+
+func resourceWizOutpostGCPRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostGCPRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readOutpostFromConfig(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	// the orchestrator key is not returned by Wiz and keeps its state
+	err := d.Set("configuration_bucket_name", data.Outpost.Config.StateBucketName)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("disable_nat_gateway", data.Outpost.Config.DisableNatGateway)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	err = d.Set("worker_service_account_email", data.Outpost.Config.WorkerAccountEmail)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizOutpostGCPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostGCPUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// the configuration is patched as a whole when any of its attributes change
+	patch := wiz.UpdateOutpostPatch{}
+	if d.HasChanges("orchestrator_key", "configuration_bucket_name", "disable_nat_gateway") {
+		config := expandOutpostGCPConfig(d)
+		patch.Config = &config
+	}
+
+	diags = updateOutpostFromPatch(ctx, d, m, patch)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	diags = waitForOutpostReady(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+
+	return appendOutpostReadDiags(diags, resourceWizOutpostGCPRead(ctx, d, m))
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizOutpostOCI() *schema.Resource {
+	return &schema.Resource{
+		Description: "This resource allows you to create, read, update, and delete a Wiz Outpost deployed in OCI.",
+		Schema: outpostSchema(map[string]*schema.Schema{
+			"compartment_ocid": {
+				Type:        schema.TypeString,
+				Description: "The OCID of the compartment the Outpost is deployed to.",
+				Required:    true,
+			},
+			"vault_ocid": {
+				Type:        schema.TypeString,
+				Description: "The OCID of the vault holding the Outpost encryption key.",
+				Required:    true,
+			},
+			"key_ocid": {
+				Type:        schema.TypeString,
+				Description: "The OCID of the Outpost encryption key.",
+				Required:    true,
+			},
+			"configuration_bucket_name": {
+				Type:        schema.TypeString,
+				Description: "The Configuration Bucket is used to configure the OKE Clusters running as part of the Outpost Connector.",
+				Required:    true,
+			},
+			"orchestrator_fingerprint": {
+				Type:        schema.TypeString,
+				Description: "The fingerprint of the orchestrator user API key.",
+				Required:    true,
+			},
+			"orchestrator_private_key": {
+				Type:        schema.TypeString,
+				Description: "The PEM encoded private key of the orchestrator user API key.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		}),
+		CustomizeDiff: outpostCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CreateContext: resourceWizOutpostOCICreate,
+		ReadContext:   resourceWizOutpostOCIRead,
+		UpdateContext: resourceWizOutpostOCIUpdate,
+		DeleteContext: resourceWizOutpostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// expandOutpostOCIConfig builds the OCI outpost configuration
+func expandOutpostOCIConfig(d *schema.ResourceData) wiz.OutpostConfigInput {
+	return wiz.OutpostConfigInput{
+		OciConfig: &wiz.OutpostOCIConfigInput{
+			CompartmentOCID: d.Get("compartment_ocid").(string),
+			VaultOCID:       d.Get("vault_ocid").(string),
+			KeyOCID:         d.Get("key_ocid").(string),
+			StateBucketName: d.Get("configuration_bucket_name").(string),
+			Orchestrator: &wiz.OutpostOCIOrchestratorInput{
+				Fingerprint: d.Get("orchestrator_fingerprint").(string),
+				PrivateKey:  d.Get("orchestrator_private_key").(string),
+			},
+		},
+	}
+}
+
+func resourceWizOutpostOCICreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostOCICreate called...")
+
+	diags = createOutpostFromConfig(ctx, d, m, "OCI", expandOutpostOCIConfig(d))
+	if len(diags) > 0 {
+		return diags
+	}
+
+	diags = waitForOutpostReady(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+
+	return appendOutpostReadDiags(diags, resourceWizOutpostOCIRead(ctx, d, m))
+}
+
+func resourceWizOutpostOCIRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostOCIRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	data, diags := readOutpostFromConfig(ctx, d, m)
+	if data == nil {
+		return diags
+	}
+
+	// the orchestrator private key is not returned by Wiz and keeps its state
+	attributes := map[string]string{
+		"compartment_ocid":          data.Outpost.Config.CompartmentOCID,
+		"vault_ocid":                data.Outpost.Config.VaultOCID,
+		"key_ocid":                  data.Outpost.Config.KeyOCID,
+		"configuration_bucket_name": data.Outpost.Config.StateBucketName,
+	}
+	if data.Outpost.Config.Orchestrator != nil {
+		attributes["orchestrator_fingerprint"] = data.Outpost.Config.Orchestrator.Fingerprint
+	}
+
+	return append(diags, setConnectorAuthAttributes(d, attributes)...)
+}
+
+func resourceWizOutpostOCIUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostOCIUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// the configuration is patched as a whole when any of its attributes change
+	patch := wiz.UpdateOutpostPatch{}
+	if d.HasChanges(
+		"compartment_ocid",
+		"vault_ocid",
+		"key_ocid",
+		"configuration_bucket_name",
+		"orchestrator_fingerprint",
+		"orchestrator_private_key",
+	) {
+		config := expandOutpostOCIConfig(d)
+		patch.Config = &config
+	}
+
+	diags = updateOutpostFromPatch(ctx, d, m, patch)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	diags = waitForOutpostReady(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+
+	return appendOutpostReadDiags(diags, resourceWizOutpostOCIRead(ctx, d, m))
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestExpandOutpostGCPConfig(t *testing.T) {
+	expected := `{"gcpConfig":{"orchestratorKey":"{\"type\":\"service_account\"}","stateBucketName":"wiz-outpost-config","disableNatGateway":true}}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizOutpostGCP().Schema,
+		map[string]interface{}{
+			"name":                      "example",
+			"orchestrator_key":          `{"type":"service_account"}`,
+			"configuration_bucket_name": "wiz-outpost-config",
+			"disable_nat_gateway":       true,
+		},
+	)
+
+	config, err := json.Marshal(expandOutpostGCPConfig(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(config) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			config,
+			expected,
+		)
+	}
+}
+
+func TestExpandOutpostAzureConfig(t *testing.T) {
+	expected := `{"azureConfig":{"tenantID":"11111111-1111-1111-1111-111111111111","subscriptionID":"22222222-2222-2222-2222-222222222222","keyVaultName":"wiz-outpost-kv","applicationKeyVaultName":"wiz-outpost-app-kv","orchestratorClientID":"33333333-3333-3333-3333-333333333333","orchestratorClientSecret":"orchestrator-secret","workerClientID":"44444444-4444-4444-4444-444444444444","workerClientSecret":"worker-secret","stateStorageAccountName":"wizoutpostconfig","globalResourceGroupName":"wiz-outpost-global","deployPremiumServiceBus":false,"enablePrivateCluster":true}}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizOutpostAzure().Schema,
+		map[string]interface{}{
+			"name":                               "example",
+			"tenant_id":                          "11111111-1111-1111-1111-111111111111",
+			"subscription_id":                    "22222222-2222-2222-2222-222222222222",
+			"key_vault_name":                     "wiz-outpost-kv",
+			"application_key_vault_name":         "wiz-outpost-app-kv",
+			"orchestrator_client_id":             "33333333-3333-3333-3333-333333333333",
+			"orchestrator_client_secret":         "orchestrator-secret",
+			"worker_client_id":                   "44444444-4444-4444-4444-444444444444",
+			"worker_client_secret":               "worker-secret",
+			"configuration_storage_account_name": "wizoutpostconfig",
+			"global_resource_group_name":         "wiz-outpost-global",
+			"enable_private_cluster":             true,
+		},
+	)
+
+	config, err := json.Marshal(expandOutpostAzureConfig(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(config) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			config,
+			expected,
+		)
+	}
+}
+
+func TestExpandOutpostOCIConfig(t *testing.T) {
+	expected := `{"ociConfig":{"compartmentOCID":"ocid1.compartment.oc1..example","vaultOCID":"ocid1.vault.oc1.iad.example","keyOCID":"ocid1.key.oc1.iad.example","stateBucketName":"wiz-outpost-config","orchestrator":{"fingerprint":"12:34:56:78:90:ab:cd:ef:12:34:56:78:90:ab:cd:ef","privateKey":"private-key"}}}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizOutpostOCI().Schema,
+		map[string]interface{}{
+			"name":                      "example",
+			"compartment_ocid":          "ocid1.compartment.oc1..example",
+			"vault_ocid":                "ocid1.vault.oc1.iad.example",
+			"key_ocid":                  "ocid1.key.oc1.iad.example",
+			"configuration_bucket_name": "wiz-outpost-config",
+			"orchestrator_fingerprint":  "12:34:56:78:90:ab:cd:ef:12:34:56:78:90:ab:cd:ef",
+			"orchestrator_private_key":  "private-key",
+		},
+	)
+
+	config, err := json.Marshal(expandOutpostOCIConfig(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(config) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			config,
+			expected,
+		)
+	}
+}
+
+func TestExpandOutpostCustomConfig(t *testing.T) {
+	expected := `{"podAnnotations":{"team":"security"},"resourceTags":{"owner":"wiz"},"namespacePrefix":"wiz-"}`
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizOutpostAWS().Schema,
+		map[string]interface{}{
+			"name": "example",
+			"custom_config": []interface{}{
+				map[string]interface{}{
+					"pod_annotations": map[string]interface{}{
+						"team": "security",
+					},
+					"resource_tags": map[string]interface{}{
+						"owner": "wiz",
+					},
+					"namespace_prefix": "wiz-",
+				},
+			},
+		},
+	)
+
+	customConfig, err := json.Marshal(expandOutpostCustomConfig(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(customConfig) != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			customConfig,
+			expected,
+		)
+	}
+
+	if len(flattenOutpostCustomConfig(wiz.OutpostCustomConfig{})) != 0 {
+		t.Fatal("expected no custom_config block for an outpost without customizations")
+	}
+}
+
+func TestOutpostReadiness(t *testing.T) {
+	// an outpost still deploying is pending
+	readiness := outpostReadiness(wiz.Outpost{Status: "DEPLOYING"})
+	if readiness != outpostReadinessPending {
+		t.Fatalf("Expected %s, got %s", outpostReadinessPending, readiness)
+	}
+
+	// an outpost that failed to deploy reports the error status
+	readiness = outpostReadiness(wiz.Outpost{Status: "ERROR"})
+	if readiness != outpostStatusError {
+		t.Fatalf("Expected %s, got %s", outpostStatusError, readiness)
+	}
+
+	// a disabled outpost stops the wait
+	readiness = outpostReadiness(wiz.Outpost{Status: "DISABLED"})
+	if readiness != outpostStatusDisabled {
+		t.Fatalf("Expected %s, got %s", outpostStatusDisabled, readiness)
+	}
+
+	// a connected outpost is pending until all its clusters are ready
+	outpost := wiz.Outpost{
+		Status: "CONNECTED",
+		Clusters: []wiz.OutpostCluster{
+			{Status: "READY"},
+			{Status: "DEPLOYING"},
+		},
+	}
+	readiness = outpostReadiness(outpost)
+	if readiness != outpostReadinessPending {
+		t.Fatalf("Expected %s, got %s", outpostReadinessPending, readiness)
+	}
+
+	// a cluster that failed to deploy stops the wait
+	outpost.Clusters[1].Status = "ERROR"
+	readiness = outpostReadiness(outpost)
+	if readiness != outpostReadinessClusterError {
+		t.Fatalf("Expected %s, got %s", outpostReadinessClusterError, readiness)
+	}
+
+	// a connected outpost with all its clusters ready is ready
+	outpost.Clusters[1].Status = "READY"
+	readiness = outpostReadiness(outpost)
+	if readiness != outpostReadinessReady {
+		t.Fatalf("Expected %s, got %s", outpostReadinessReady, readiness)
+	}
+}
+
+func TestFlattenOutpostClusters(t *testing.T) {
+	cluster := wiz.OutpostCluster{
+		ID:     "5c0a2f83-6f3a-4c5b-9b0e-1f4d5b6a7c8d",
+		Region: "us-east-1",
+		Status: "READY",
+	}
+	cluster.Config.ClusterName = "wiz-outpost-us-east-1"
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":           "5c0a2f83-6f3a-4c5b-9b0e-1f4d5b6a7c8d",
+			"region":       "us-east-1",
+			"cluster_name": "wiz-outpost-us-east-1",
+			"status":       "READY",
+			"ready":        true,
+		},
+	}
+
+	clusters := flattenOutpostClusters([]wiz.OutpostCluster{cluster})
+	if !reflect.DeepEqual(clusters, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			clusters,
+			expected,
+		)
+	}
+}
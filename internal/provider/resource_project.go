@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/google/uuid"
@@ -319,14 +321,20 @@ func resourceWizProject() *schema.Resource {
 			"cloud_account_link": {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "Associate the project directly with a cloud account by wiz identifier UID to organize all the subscription resources, issues, and findings within this project.",
-
+				Description: "Associate the project directly with a cloud account by wiz identifier UID or external identifier to organize all the subscription resources, issues, and findings within this project.",
+				Set:         cloudAccountLinkHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cloud_account_id": {
 							Type:        schema.TypeString,
-							Description: "The Wiz internal identifier for the Cloud Account Subscription.",
-							Required:    true,
+							Description: "The Wiz internal identifier for the Cloud Account Subscription. Either `cloud_account_id` or `cloud_account_external_id` must be set, it is resolved from `cloud_account_external_id` when not set.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"cloud_account_external_id": {
+							Type:        schema.TypeString,
+							Description: "The cloud provider identifier of the Cloud Account Subscription (AWS account ID, GCP project ID, Azure subscription ID), resolved to `cloud_account_id` by the provider. An error is returned if it matches no or several cloud accounts, or a different cloud account than `cloud_account_id` when both are set.",
+							Optional:    true,
 						},
 						"environment": {
 							Type: schema.TypeString,
@@ -544,7 +552,124 @@ func getKubernetesClusterLinksVar(ctx context.Context, d *schema.ResourceData) [
 	return myClusters
 }
 
-func getAccountLinksVar(ctx context.Context, d *schema.ResourceData) []*wiz.ProjectCloudAccountLinkInput {
+// cloudAccountLinkHash hashes a cloud account link on the identifier set in the configuration,
+// so resolving `cloud_account_id` from `cloud_account_external_id` doesn't change the link hash
+func cloudAccountLinkHash(v interface{}) int {
+	link := v.(map[string]interface{})
+
+	var buf bytes.Buffer
+	if externalID, ok := link["cloud_account_external_id"].(string); ok && externalID != "" {
+		buf.WriteString(fmt.Sprintf("external_id:%s;", externalID))
+	} else {
+		buf.WriteString(fmt.Sprintf("id:%v;", link["cloud_account_id"]))
+	}
+	buf.WriteString(fmt.Sprintf("environment:%v;", link["environment"]))
+	buf.WriteString(fmt.Sprintf("shared:%v;", link["shared"]))
+	if resourceGroups, ok := link["resource_groups"].([]interface{}); ok {
+		for _, resourceGroup := range resourceGroups {
+			buf.WriteString(fmt.Sprintf("resource_group:%v;", resourceGroup))
+		}
+	}
+	if resourceTags, ok := link["resource_tags"].(*schema.Set); ok {
+		var tags []string
+		for _, resourceTag := range resourceTags.List() {
+			tag := resourceTag.(map[string]interface{})
+			tags = append(tags, fmt.Sprintf("resource_tag:%v=%v;", tag["key"], tag["value"]))
+		}
+		sort.Strings(tags)
+		buf.WriteString(strings.Join(tags, ""))
+	}
+
+	return schema.HashString(buf.String())
+}
+
+// cloudAccountLinkExternalIDs returns the external identifiers of the cloud account links
+func cloudAccountLinkExternalIDs(d *schema.ResourceData) []string {
+	var externalIDs []string
+	for _, link := range d.Get("cloud_account_link").(*schema.Set).List() {
+		if externalID := link.(map[string]interface{})["cloud_account_external_id"].(string); externalID != "" {
+			externalIDs = append(externalIDs, externalID)
+		}
+	}
+
+	return externalIDs
+}
+
+// resolveCloudAccountLinks returns the Wiz identifiers of the cloud account links set by external identifier
+func resolveCloudAccountLinks(ctx context.Context, d *schema.ResourceData, m interface{}) (map[string]string, diag.Diagnostics) {
+	for _, link := range d.Get("cloud_account_link").(*schema.Set).List() {
+		linkMap := link.(map[string]interface{})
+		if linkMap["cloud_account_id"].(string) == "" && linkMap["cloud_account_external_id"].(string) == "" {
+			return nil, diag.Errorf("cloud_account_link requires either cloud_account_id or cloud_account_external_id")
+		}
+	}
+
+	externalIDs := cloudAccountLinkExternalIDs(d)
+	if len(externalIDs) == 0 {
+		return nil, nil
+	}
+
+	resolved, diags := resolveCloudAccountExternalIDs(ctx, m, externalIDs)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// cloud_account_id is computed from the external identifier, only a value set in the configuration must match it
+	diags = append(diags, checkCloudAccountLinkIDs(cloudAccountLinkConfiguredIDs(d), resolved)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return resolved, diags
+}
+
+// checkCloudAccountLinkIDs returns an error when a configured cloud_account_id differs from the identifier its external identifier resolves to
+func checkCloudAccountLinkIDs(configured map[string]string, resolved map[string]string) (diags diag.Diagnostics) {
+	externalIDs := make([]string, 0, len(configured))
+	for externalID := range configured {
+		externalIDs = append(externalIDs, externalID)
+	}
+	sort.Strings(externalIDs)
+
+	for _, externalID := range externalIDs {
+		if resolved[externalID] != configured[externalID] {
+			diags = append(diags, diag.Errorf("cloud_account_link sets cloud_account_id %s but cloud_account_external_id %s resolves to %s, set only one of them", configured[externalID], externalID, resolved[externalID])...)
+		}
+	}
+
+	return diags
+}
+
+// cloudAccountLinkConfiguredIDs returns the cloud_account_id set in the configuration of the links also setting cloud_account_external_id, keyed by external identifier
+func cloudAccountLinkConfiguredIDs(d *schema.ResourceData) map[string]string {
+	configured := make(map[string]string)
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return configured
+	}
+	links := rawConfig.GetAttr("cloud_account_link")
+	if links.IsNull() || !links.IsKnown() {
+		return configured
+	}
+
+	for it := links.ElementIterator(); it.Next(); {
+		_, link := it.Element()
+		cloudAccountID := link.GetAttr("cloud_account_id")
+		externalID := link.GetAttr("cloud_account_external_id")
+		if cloudAccountID.IsNull() || !cloudAccountID.IsKnown() || externalID.IsNull() || !externalID.IsKnown() {
+			continue
+		}
+		if cloudAccountID.AsString() == "" || externalID.AsString() == "" {
+			continue
+		}
+		configured[externalID.AsString()] = cloudAccountID.AsString()
+	}
+
+	return configured
+}
+
+func getAccountLinksVar(ctx context.Context, d *schema.ResourceData, externalIDs map[string]string) []*wiz.ProjectCloudAccountLinkInput {
 	accountSet := d.Get("cloud_account_link").(*schema.Set).List()
 	var myAccounts []*wiz.ProjectCloudAccountLinkInput
 	for _, y := range accountSet {
@@ -579,6 +704,10 @@ func getAccountLinksVar(ctx context.Context, d *schema.ResourceData) []*wiz.Proj
 				localAccount.ResourceTags = myResourceTags
 			}
 		}
+		// a configured cloud_account_id matches the resolved identifier, checked by resolveCloudAccountLinks
+		if externalID := y.(map[string]interface{})["cloud_account_external_id"].(string); externalID != "" {
+			localAccount.CloudAccount = externalIDs[externalID]
+		}
 
 		myAccounts = append(myAccounts, &localAccount)
 	}
@@ -611,7 +740,12 @@ func resourceWizProjectCreate(ctx context.Context, d *schema.ResourceData, m int
 	vars.Description = d.Get("description").(string)
 	vars.BusinessUnit = d.Get("business_unit").(string)
 	vars.CloudOrganizationLinks = getOrganizationLinksVar(ctx, d)
-	vars.CloudAccountLinks = getAccountLinksVar(ctx, d)
+	cloudAccountExternalIDs, resolveDiags := resolveCloudAccountLinks(ctx, d, m)
+	diags = append(diags, resolveDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	vars.CloudAccountLinks = getAccountLinksVar(ctx, d, cloudAccountExternalIDs)
 	vars.Identifiers = utils.ConvertListToString(d.Get("identifiers").([]interface{}))
 	vars.KubernetesClusterLinks = getKubernetesClusterLinksVar(ctx, d)
 	vars.RiskProfile.BusinessImpact = d.Get("risk_profile.0.business_impact").(string)
@@ -703,12 +837,18 @@ func flattenCloudOrganizationLinks(ctx context.Context, cloudOrganizationLink []
 	return output
 }
 
-func flattenCloudAccountLinks(ctx context.Context, cloudAccountLink []*wiz.ProjectCloudAccountLink) []interface{} {
+func flattenCloudAccountLinks(ctx context.Context, cloudAccountLink []*wiz.ProjectCloudAccountLink, externalIDs []string) []interface{} {
 	var output = make([]interface{}, 0)
 
 	for _, b := range cloudAccountLink {
 		cloudAccountLinksMap := make(map[string]interface{})
 		cloudAccountLinksMap["cloud_account_id"] = b.CloudAccount.ID
+		// the external identifier is only kept for the links configured with it
+		for _, externalID := range externalIDs {
+			if strings.EqualFold(externalID, b.CloudAccount.ExternalID) {
+				cloudAccountLinksMap["cloud_account_external_id"] = externalID
+			}
+		}
 		cloudAccountLinksMap["shared"] = b.Shared
 		cloudAccountLinksMap["environment"] = b.Environment
 
@@ -919,7 +1059,7 @@ func resourceWizProjectRead(ctx context.Context, d *schema.ResourceData, m inter
 	if err := d.Set("cloud_organization_link", cloudOrganizationLinks); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
-	cloudAccountLinks := flattenCloudAccountLinks(ctx, data.Project.CloudAccountLinks)
+	cloudAccountLinks := flattenCloudAccountLinks(ctx, data.Project.CloudAccountLinks, cloudAccountLinkExternalIDs(d))
 	if err := d.Set("cloud_account_link", cloudAccountLinks); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
@@ -1022,6 +1162,12 @@ func resourceWizProjectUpdate(ctx context.Context, d *schema.ResourceData, m int
 	}
 	vars.Override.CloudOrganizationLinks = updateOrgLinks
 
+	cloudAccountExternalIDs, resolveDiags := resolveCloudAccountLinks(ctx, d, m)
+	diags = append(diags, resolveDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
 	var updateAccountLinks = []*wiz.ProjectCloudAccountLinkInput{}
 	cloudAccountLinks := d.Get("cloud_account_link").(*schema.Set).List()
 	for _, b := range cloudAccountLinks {
@@ -1053,6 +1199,10 @@ func resourceWizProjectUpdate(ctx context.Context, d *schema.ResourceData, m int
 				updateAccountLink.ResourceTags = updateResourceTags
 			}
 		}
+		// a configured cloud_account_id matches the resolved identifier, checked by resolveCloudAccountLinks
+		if externalID := b.(map[string]interface{})["cloud_account_external_id"].(string); externalID != "" {
+			updateAccountLink.CloudAccount = cloudAccountExternalIDs[externalID]
+		}
 		updateAccountLinks = append(updateAccountLinks, updateAccountLink)
 	}
 	vars.Override.CloudAccountLinks = updateAccountLinks
@@ -231,7 +231,7 @@ func TestFlattenCloudAccountLinksWithTags(t *testing.T) {
 	expanded := []*wiz.ProjectCloudAccountLink{}
 	expanded = append(expanded, projectCloudAccountLink1)
 
-	cloudAccountLinks := flattenCloudAccountLinks(ctx, expanded)
+	cloudAccountLinks := flattenCloudAccountLinks(ctx, expanded, nil)
 
 	if !reflect.DeepEqual(cloudAccountLinks, expected) {
 		t.Fatalf(
@@ -268,7 +268,7 @@ func TestFlattenCloudAccountLinksNoTags(t *testing.T) {
 	expanded := []*wiz.ProjectCloudAccountLink{}
 	expanded = append(expanded, projectCloudAccountLink1)
 
-	cloudAccountLinks := flattenCloudAccountLinks(ctx, expanded)
+	cloudAccountLinks := flattenCloudAccountLinks(ctx, expanded, nil)
 
 	if !reflect.DeepEqual(cloudAccountLinks, expected) {
 		t.Fatalf(
@@ -338,7 +338,7 @@ func TestGetCloudAccountLinksVar(t *testing.T) {
 		},
 	)
 
-	accLink := getAccountLinksVar(ctx, d)
+	accLink := getAccountLinksVar(ctx, d, nil)
 
 	sort.SliceStable(expected, func(i, j int) bool { return expected[i].CloudAccount < expected[j].CloudAccount })
 	sort.SliceStable(accLink, func(i, j int) bool { return accLink[i].CloudAccount < accLink[j].CloudAccount })
@@ -535,3 +535,89 @@ func TestGetOrganizationLinksVar(t *testing.T) {
 		)
 	}
 }
+
+func TestGetCloudAccountLinksVarExternalID(t *testing.T) {
+	ctx := context.Background()
+
+	var expected = []*wiz.ProjectCloudAccountLinkInput{
+		{
+			CloudAccount: "3225def3-0e0e-5cb8-955a-3583f696f77f",
+			Environment:  "STAGING",
+			Shared:       utils.ConvertBoolToPointer(false),
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizProject().Schema,
+		map[string]interface{}{
+			"name": "my project",
+			"cloud_account_link": []interface{}{
+				map[string]interface{}{
+					"cloud_account_external_id": "100000000009",
+					"environment":               "STAGING",
+				},
+			},
+		},
+	)
+
+	accLink := getAccountLinksVar(ctx, d, map[string]string{
+		"100000000009": "3225def3-0e0e-5cb8-955a-3583f696f77f",
+	})
+
+	if !reflect.DeepEqual(expected, accLink) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			utils.PrettyPrint(accLink),
+			utils.PrettyPrint(expected),
+		)
+	}
+}
+
+func TestCheckCloudAccountLinkIDs(t *testing.T) {
+	resolved := map[string]string{
+		"100000000009": "3225def3-0e0e-5cb8-955a-3583f696f77f",
+		"100000000010": "5b8a1f2e-3c4d-5e6f-7a8b-9c0d1e2f3a4b",
+	}
+
+	diags := checkCloudAccountLinkIDs(map[string]string{
+		"100000000009": "3225def3-0e0e-5cb8-955a-3583f696f77f",
+	}, resolved)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	diags = checkCloudAccountLinkIDs(map[string]string{
+		"100000000009": "3225def3-0e0e-5cb8-955a-3583f696f77f",
+		"100000000010": "3225def3-0e0e-5cb8-955a-3583f696f77f",
+	}, resolved)
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("expected an error for the cloud_account_id not matching its external identifier, got %v", diags)
+	}
+}
+
+func TestCloudAccountLinkHash(t *testing.T) {
+	configured := map[string]interface{}{
+		"cloud_account_id":          "",
+		"cloud_account_external_id": "100000000009",
+		"environment":               "PRODUCTION",
+		"shared":                    false,
+		"resource_groups":           []interface{}{},
+	}
+	resolved := map[string]interface{}{
+		"cloud_account_id":          "3225def3-0e0e-5cb8-955a-3583f696f77f",
+		"cloud_account_external_id": "100000000009",
+		"environment":               "PRODUCTION",
+		"shared":                    false,
+		"resource_groups":           []interface{}{},
+	}
+
+	if cloudAccountLinkHash(configured) != cloudAccountLinkHash(resolved) {
+		t.Fatal("expected the resolved cloud account id not to change the link hash")
+	}
+
+	resolved["environment"] = "STAGING"
+	if cloudAccountLinkHash(configured) == cloudAccountLinkHash(resolved) {
+		t.Fatal("expected the environment to change the link hash")
+	}
+}
@@ -21,6 +21,15 @@ func ConvertListToString(input []interface{}) []string {
 	return strings
 }
 
+// ConvertMapToStringMap converts schema.TypeMap of strings to a map of strings
+func ConvertMapToStringMap(input map[string]interface{}) map[string]string {
+	output := make(map[string]string, len(input))
+	for key, value := range input {
+		output[key] = value.(string)
+	}
+	return output
+}
+
 // ConvertBoolToPointer converts a bool to a pointer to bool
 func ConvertBoolToPointer(in bool) *bool {
 	t := new(bool)
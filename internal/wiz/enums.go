@@ -138,6 +138,14 @@ var ConnectorStatus = []string{
 	"DISABLED",
 }
 
+// ContainerRegistryScanFrequency enum
+// We deviate from the GraphQL schema because the scan frequency is part of the extraConfig JSON scalar; the values are not defined by the GraphQL schema and are unconfirmed
+var ContainerRegistryScanFrequency = []string{
+	"HOURLY",
+	"DAILY",
+	"WEEKLY",
+}
+
 // ConnectorErrorCode enum
 var ConnectorErrorCode = []string{
 	"CONNECTION_ERROR",
@@ -2,18 +2,18 @@ package wiz
 
 // Outpost struct
 type Outpost struct {
-	ID                     string               `json:"id"`
-	Name                   string               `json:"name"`
-	Enabled                bool                 `json:"enabled"`
-	ServiceType            string               `json:"serviceType"`
-	AllowedRegions         []string             `json:"allowedRegions"`
-	SelfManaged            bool                 `json:"selfManaged"`
-	ExternalInternetAccess string               `json:"externalInternetAccess"`
-	SelfManagedConfig      any                  `json:"selfManagedConfig"`
-	ManagedConfig          OutpostManagedConfig `json:"managedConfig"`
-	Status                 string               `json:"status"`
-	ErrorCode              any                  `json:"errorCode"`
-	CreatedAt              string               `json:"createdAt"`
+	ID                     string                    `json:"id"`
+	Name                   string                    `json:"name"`
+	Enabled                bool                      `json:"enabled"`
+	ServiceType            string                    `json:"serviceType"`
+	AllowedRegions         []string                  `json:"allowedRegions"`
+	SelfManaged            bool                      `json:"selfManaged"`
+	ExternalInternetAccess string                    `json:"externalInternetAccess"`
+	SelfManagedConfig      *OutpostSelfManagedConfig `json:"selfManagedConfig"`
+	ManagedConfig          OutpostManagedConfig      `json:"managedConfig"`
+	Status                 string                    `json:"status"`
+	ErrorCode              string                    `json:"errorCode"`
+	CreatedAt              string                    `json:"createdAt"`
 	AddedBy                struct {
 		ID    string `json:"id"`
 		Name  string `json:"name"`
@@ -26,18 +26,13 @@ type Outpost struct {
 
 // OutpostCluster struct
 type OutpostCluster struct {
-	ID              string `json:"id"`
-	Region          string `json:"region"`
-	CreatedAt       string `json:"createdAt"`
-	HTTPProxyConfig any    `json:"httpProxyConfig"`
-	NodeGroups      []struct {
-		NodeGroupID  string `json:"nodeGroupId"`
-		Type         string `json:"type"`
-		MaxNodeCount int    `json:"maxNodeCount"`
-		MinNodeCount int    `json:"minNodeCount"`
-		Typename     string `json:"__typename"`
-	} `json:"nodeGroups"`
-	Config struct {
+	ID              string                         `json:"id"`
+	Region          string                         `json:"region"`
+	Status          string                         `json:"status"`
+	CreatedAt       string                         `json:"createdAt"`
+	HTTPProxyConfig *OutpostClusterHTTPProxyConfig `json:"httpProxyConfig"`
+	NodeGroups      []OutpostClusterNodeGroup      `json:"nodeGroups"`
+	Config          struct {
 		ClusterName                  string `json:"clusterName"`
 		SqsURL                       string `json:"sqsURL"`
 		KubernetesServiceAccountName any    `json:"kubernetesServiceAccountName"`
@@ -51,11 +46,42 @@ type OutpostCluster struct {
 	} `json:"addedBy"`
 }
 
+// OutpostClusterNodeGroup struct
+type OutpostClusterNodeGroup struct {
+	NodeGroupID  string `json:"nodeGroupId"`
+	Type         string `json:"type"`
+	MaxNodeCount int    `json:"maxNodeCount"`
+	MinNodeCount int    `json:"minNodeCount"`
+	Typename     string `json:"__typename"`
+}
+
+// OutpostClusterHTTPProxyConfig struct
+type OutpostClusterHTTPProxyConfig struct {
+	HTTPProxyURL  string   `json:"httpProxyURL,omitempty"`
+	HTTPSProxyURL string   `json:"httpsProxyURL,omitempty"`
+	VpcCIDRs      []string `json:"vpcCIDRs,omitempty"`
+}
+
 // OutpostCustomConfig struct
 type OutpostCustomConfig struct {
-	PodAnnotations  any `json:"podAnnotations"`
-	ResourceTags    any `json:"resourceTags"`
-	NamespacePrefix any `json:"namespacePrefix"`
+	PodAnnotations  map[string]string `json:"podAnnotations,omitempty"`
+	ResourceTags    map[string]string `json:"resourceTags,omitempty"`
+	NamespacePrefix string            `json:"namespacePrefix,omitempty"`
+}
+
+// OutpostSelfManagedConfig struct
+type OutpostSelfManagedConfig struct {
+	DisableAutomaticConfigurationBucketSync bool            `json:"disableAutomaticConfigurationBucketSync"`
+	ImageRepository                         string          `json:"imageRepository,omitempty"`
+	Version                                 *OutpostVersion `json:"version,omitempty"`
+}
+
+// OutpostVersion struct
+type OutpostVersion struct {
+	ID     string `json:"id"`
+	Images []struct {
+		URL string `json:"url"`
+	} `json:"images"`
 }
 
 // OutpostManagedConfig struct
@@ -65,32 +91,63 @@ type OutpostManagedConfig struct {
 	KubernetesCloudMonitoringEnabled bool `json:"kubernetesCloudMonitoringEnabled"`
 }
 
-// OutpostConfig struct
+// OutpostConfig struct -- union of OutpostAWSConfig, OutpostGCPConfig, OutpostAzureConfig and OutpostOCIConfig
 type OutpostConfig struct {
-	RoleARN           string `json:"roleARN"`
-	ExternalID        string `json:"externalID"`
-	StateBucketName   string `json:"stateBucketName,omitempty"`
-	SettingsRegion    string `json:"settingsRegion,omitempty"`
-	AccessKey         string `json:"accessKey"`
-	SecretKey         string `json:"secretKey"`
-	DisableNatGateway bool   `json:"disableNatGateway,omitempty"`
-	ResultsBucketName string `json:"resultsBucketName,omitempty"`
-	SubscriptionID    string `json:"subscriptionID"`
+	RoleARN                  string                  `json:"roleARN"`
+	ExternalID               string                  `json:"externalID"`
+	StateBucketName          string                  `json:"stateBucketName,omitempty"`
+	SettingsRegion           string                  `json:"settingsRegion,omitempty"`
+	AccessKey                string                  `json:"accessKey"`
+	SecretKey                string                  `json:"secretKey"`
+	DisableNatGateway        bool                    `json:"disableNatGateway,omitempty"`
+	ResultsBucketName        string                  `json:"resultsBucketName,omitempty"`
+	SubscriptionID           string                  `json:"subscriptionID"`
+	OrchestratorKey          string                  `json:"orchestratorKey,omitempty"`
+	WorkerAccountEmail       string                  `json:"workerAccountEmail,omitempty"`
+	TenantID                 string                  `json:"tenantID,omitempty"`
+	KeyVaultName             string                  `json:"keyVaultName,omitempty"`
+	ApplicationKeyVaultName  string                  `json:"applicationKeyVaultName,omitempty"`
+	OrchestratorClientID     string                  `json:"orchestratorClientID,omitempty"`
+	OrchestratorClientSecret string                  `json:"orchestratorClientSecret,omitempty"`
+	WorkerClientID           string                  `json:"workerClientID,omitempty"`
+	WorkerClientSecret       string                  `json:"workerClientSecret,omitempty"`
+	ScannerAppID             string                  `json:"scannerAppID,omitempty"`
+	DeployPremiumServiceBus  bool                    `json:"deployPremiumServiceBus,omitempty"`
+	EnablePrivateCluster     bool                    `json:"enablePrivateCluster,omitempty"`
+	Environment              string                  `json:"environment,omitempty"`
+	StateStorageAccountName  string                  `json:"stateStorageAccountName,omitempty"`
+	GlobalResourceGroupName  string                  `json:"globalResourceGroupName,omitempty"`
+	CompartmentOCID          string                  `json:"compartmentOCID,omitempty"`
+	VaultOCID                string                  `json:"vaultOCID,omitempty"`
+	KeyOCID                  string                  `json:"keyOCID,omitempty"`
+	Orchestrator             *OutpostOCIOrchestrator `json:"orchestrator,omitempty"`
+}
+
+// OutpostOCIOrchestrator struct
+type OutpostOCIOrchestrator struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	PrivateKey  string `json:"privateKey,omitempty"`
 }
 
 // CreateOutpostInput struct
 type CreateOutpostInput struct {
-	Name           string                    `json:"name,omitempty"`
-	ServiceType    string                    `json:"serviceType,omitempty"`
-	Enabled        *bool                     `json:"enabled,omitempty"`
-	SelfManaged    *bool                     `json:"selfManaged,omitempty"`
-	Config         OutpostConfigInput        `json:"config"`
-	ManagedConfig  OutpostManagedConfigInput `json:"managedConfig"`
-	AllowedRegions []string                  `json:"allowedRegions,omitempty"`
+	Name              string                         `json:"name,omitempty"`
+	ServiceType       string                         `json:"serviceType,omitempty"`
+	Enabled           *bool                          `json:"enabled,omitempty"`
+	SelfManaged       *bool                          `json:"selfManaged,omitempty"`
+	Config            OutpostConfigInput             `json:"config"`
+	ManagedConfig     OutpostManagedConfigInput      `json:"managedConfig"`
+	CustomConfig      *OutpostCustomConfig           `json:"customConfig,omitempty"`
+	SelfManagedConfig *OutpostSelfManagedConfigInput `json:"selfManagedConfig,omitempty"`
+	AllowedRegions    []string                       `json:"allowedRegions,omitempty"`
 }
 
+// OutpostConfigInput struct -- exactly one of the provider configurations is set
 type OutpostConfigInput struct {
-	AwsConfig OutpostAWSConfigInput `json:"awsConfig"`
+	AwsConfig   *OutpostAWSConfigInput   `json:"awsConfig,omitempty"`
+	GcpConfig   *OutpostGCPConfigInput   `json:"gcpConfig,omitempty"`
+	AzureConfig *OutpostAzureConfigInput `json:"azureConfig,omitempty"`
+	OciConfig   *OutpostOCIConfigInput   `json:"ociConfig,omitempty"`
 }
 
 // OutpostAWSConfig struct
@@ -106,6 +163,46 @@ type OutpostAWSConfigInput struct {
 	SubscriptionID    string `json:"subscriptionID,omitempty"`
 }
 
+// OutpostGCPConfigInput struct
+type OutpostGCPConfigInput struct {
+	OrchestratorKey   string `json:"orchestratorKey,omitempty"`
+	StateBucketName   string `json:"stateBucketName,omitempty"`
+	DisableNatGateway *bool  `json:"disableNatGateway,omitempty"`
+}
+
+// OutpostAzureConfigInput struct
+type OutpostAzureConfigInput struct {
+	TenantID                 string `json:"tenantID,omitempty"`
+	SubscriptionID           string `json:"subscriptionID,omitempty"`
+	KeyVaultName             string `json:"keyVaultName,omitempty"`
+	ApplicationKeyVaultName  string `json:"applicationKeyVaultName,omitempty"`
+	OrchestratorClientID     string `json:"orchestratorClientID,omitempty"`
+	OrchestratorClientSecret string `json:"orchestratorClientSecret,omitempty"`
+	WorkerClientID           string `json:"workerClientID,omitempty"`
+	WorkerClientSecret       string `json:"workerClientSecret,omitempty"`
+	ScannerAppID             string `json:"scannerAppID,omitempty"`
+	Environment              string `json:"environment,omitempty"`
+	StateStorageAccountName  string `json:"stateStorageAccountName,omitempty"`
+	GlobalResourceGroupName  string `json:"globalResourceGroupName,omitempty"`
+	DeployPremiumServiceBus  *bool  `json:"deployPremiumServiceBus,omitempty"`
+	EnablePrivateCluster     *bool  `json:"enablePrivateCluster,omitempty"`
+}
+
+// OutpostOCIConfigInput struct
+type OutpostOCIConfigInput struct {
+	CompartmentOCID string                       `json:"compartmentOCID,omitempty"`
+	VaultOCID       string                       `json:"vaultOCID,omitempty"`
+	KeyOCID         string                       `json:"keyOCID,omitempty"`
+	StateBucketName string                       `json:"stateBucketName,omitempty"`
+	Orchestrator    *OutpostOCIOrchestratorInput `json:"orchestrator,omitempty"`
+}
+
+// OutpostOCIOrchestratorInput struct
+type OutpostOCIOrchestratorInput struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	PrivateKey  string `json:"privateKey,omitempty"`
+}
+
 // OutpostManagedConfig struct
 type OutpostManagedConfigInput struct {
 	KubernetesLoggingEnabled         *bool `json:"kubernetesLoggingEnabled"`
@@ -113,6 +210,13 @@ type OutpostManagedConfigInput struct {
 	KubernetesCloudMonitoringEnabled *bool `json:"kubernetesCloudMonitoringEnabled"`
 }
 
+// OutpostSelfManagedConfigInput struct
+type OutpostSelfManagedConfigInput struct {
+	DisableAutomaticConfigurationBucketSync *bool  `json:"disableAutomaticConfigurationBucketSync,omitempty"`
+	ImageRepository                         string `json:"imageRepository,omitempty"`
+	VersionID                               string `json:"versionId,omitempty"`
+}
+
 // CreateOutpostPayload struct
 type CreateOutpostPayload struct {
 	Outpost Outpost `json:"outpost,omitempty"`
@@ -136,15 +240,67 @@ type UpdateOutpostInput struct {
 
 // UpdateOutpostPatch struct
 type UpdateOutpostPatch struct {
-	Enabled           *bool    `json:"enabled,omitempty"`
-	Name              string   `json:"name,omitempty"`
-	StateBucketName   string   `json:"stateBucketName,omitempty"`
-	DisableNatGateway *bool    `json:"disableNatGateway,omitempty"`
-	AllowedRegions    []string `json:"allowedRegions,omitempty"`
-	ResultsBucketName string   `json:"resultsBucketName,omitempty"`
+	Enabled           *bool                          `json:"enabled,omitempty"`
+	Name              string                         `json:"name,omitempty"`
+	StateBucketName   string                         `json:"stateBucketName,omitempty"`
+	DisableNatGateway *bool                          `json:"disableNatGateway,omitempty"`
+	AllowedRegions    []string                       `json:"allowedRegions,omitempty"`
+	ResultsBucketName string                         `json:"resultsBucketName,omitempty"`
+	Config            *OutpostConfigInput            `json:"config,omitempty"`
+	ManagedConfig     *OutpostManagedConfigInput     `json:"managedConfig,omitempty"`
+	CustomConfig      *OutpostCustomConfig           `json:"customConfig,omitempty"`
+	SelfManagedConfig *OutpostSelfManagedConfigInput `json:"selfManagedConfig,omitempty"`
 }
 
 // UpdateOutpostPayload struct
 type UpdateOutpostPayload struct {
 	Outpost Outpost `json:"outpost,omitempty"`
 }
+
+// CreateOutpostClusterInput struct
+type CreateOutpostClusterInput struct {
+	OutpostID       string                         `json:"outpostId"`
+	Region          string                         `json:"region"`
+	NodeGroups      []OutpostClusterNodeGroupInput `json:"nodeGroups,omitempty"`
+	HTTPProxyConfig *OutpostClusterHTTPProxyConfig `json:"httpProxyConfig,omitempty"`
+}
+
+// OutpostClusterNodeGroupInput struct
+type OutpostClusterNodeGroupInput struct {
+	NodeGroupID  string `json:"nodeGroupId,omitempty"`
+	Type         string `json:"type"`
+	MinNodeCount int    `json:"minNodeCount"`
+	MaxNodeCount int    `json:"maxNodeCount"`
+}
+
+// CreateOutpostClusterPayload struct
+type CreateOutpostClusterPayload struct {
+	OutpostCluster OutpostCluster `json:"outpostCluster,omitempty"`
+}
+
+// UpdateOutpostClusterInput struct
+type UpdateOutpostClusterInput struct {
+	ID    string                    `json:"id"`
+	Patch UpdateOutpostClusterPatch `json:"patch"`
+}
+
+// UpdateOutpostClusterPatch struct
+type UpdateOutpostClusterPatch struct {
+	NodeGroups      []OutpostClusterNodeGroupInput `json:"nodeGroups,omitempty"`
+	HTTPProxyConfig *OutpostClusterHTTPProxyConfig `json:"httpProxyConfig,omitempty"`
+}
+
+// UpdateOutpostClusterPayload struct
+type UpdateOutpostClusterPayload struct {
+	OutpostCluster OutpostCluster `json:"outpostCluster,omitempty"`
+}
+
+// DeleteOutpostClusterInput struct
+type DeleteOutpostClusterInput struct {
+	ID string `json:"id"`
+}
+
+// DeleteOutpostClusterPayload struct
+type DeleteOutpostClusterPayload struct {
+	Stub string `json:"_stub,omitempty"`
+}
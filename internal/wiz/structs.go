@@ -580,14 +580,13 @@ type Connector struct {
 	CloudAccountCount int             `json:"cloudAccountCount"`
 	CreatedAt         string          `json:"createdAt"`
 	Enabled           bool            `json:"enabled"`
+	ErrorCode         string          `json:"errorCode,omitempty"` // enum ConnectorErrorCode
 	ExtraConfig       json.RawMessage `json:"extraConfig,omitempty"`
 	ID                string          `json:"id"`
 	Name              string          `json:"name"`
 	Status            string          `json:"status"` // enum ConnectorStatus
 }
 
-
-
 // ConnectorConfigGCP struct -- updates
 type ConnectorConfigGCP struct {
 	AuthProviderX509CertURL      string                      `json:"auth_provider_x509_cert_url"`
@@ -613,6 +612,16 @@ type ConnectorConfigGCP struct {
 	Type                         string                      `json:"type"`
 }
 
+// ConnectorExtraConfigGCP struct
+type ConnectorExtraConfigGCP struct {
+	Projects                     []string `json:"projects"`
+	ExcludedProjects             []string `json:"excludedProjects"`
+	IncludedFolders              []string `json:"includedFolders"`
+	ExcludedFolders              []string `json:"excludedFolders"`
+	AuditLogMonitorEnabled       bool     `json:"auditLogMonitorEnabled"`
+	DiskAnalyzerInFlightDisabled bool     `json:"diskAnalyzerInFlightDisabled"`
+}
+
 // ConnectorConfigGCPAuditLogs struct -- updates
 type ConnectorConfigGCPAuditLogs struct {
 	PubSub ConnectorConfigGCPPubSub `json:"pub_sub"`
@@ -624,6 +633,50 @@ type ConnectorConfigGCPPubSub struct {
 	TopicName      string `json:"topicName"`
 }
 
+// ConnectorConfigAzure struct -- updates
+type ConnectorConfigAzure struct {
+	AuditLogMonitorEnabled       bool     `json:"auditLogMonitorEnabled"`
+	DiskAnalyzerInFlightDisabled bool     `json:"diskAnalyzerInFlightDisabled"`
+	Environment                  string   `json:"environment,omitempty"`
+	ExcludedManagementGroups     []string `json:"excludedManagementGroups,omitempty"`
+	ExcludedSubscriptions        []string `json:"excludedSubscriptions,omitempty"`
+	IncludedSubscriptions        []string `json:"includedSubscriptions,omitempty"`
+	IsManagedIdentity            bool     `json:"isManagedIdentity"`
+	ManagementGroupID            string   `json:"managementGroupId,omitempty"`
+	SnapshotsResourceGroupID     string   `json:"snapshotsResourceGroupId,omitempty"`
+	SubscriptionID               string   `json:"subscriptionId,omitempty"`
+	TenantID                     string   `json:"tenantId"`
+}
+
+// ConnectorExtraConfigAzure struct
+type ConnectorExtraConfigAzure struct {
+	IncludedSubscriptions        []string `json:"includedSubscriptions"`
+	ExcludedSubscriptions        []string `json:"excludedSubscriptions"`
+	ExcludedManagementGroups     []string `json:"excludedManagementGroups"`
+	AuditLogMonitorEnabled       bool     `json:"auditLogMonitorEnabled"`
+	DiskAnalyzerInFlightDisabled bool     `json:"diskAnalyzerInFlightDisabled"`
+}
+
+// ConnectorConfigOCI struct -- updates
+type ConnectorConfigOCI struct {
+	DiskAnalyzerInFlightDisabled bool     `json:"diskAnalyzerInFlightDisabled"`
+	ExcludedCompartments         []string `json:"excludedCompartments,omitempty"`
+	Fingerprint                  string   `json:"fingerprint,omitempty"`
+	HomeRegion                   string   `json:"homeRegion,omitempty"`
+	IncludedCompartments         []string `json:"includedCompartments,omitempty"`
+	TenancyOCID                  string   `json:"tenancyOCID"`
+	UserOCID                     string   `json:"userOCID,omitempty"`
+}
+
+// ConnectorConfigAlibaba struct -- updates
+type ConnectorConfigAlibaba struct {
+	AccessKeyID                  string   `json:"accessKeyId,omitempty"`
+	AccountID                    string   `json:"accountId"`
+	DiskAnalyzerInFlightDisabled bool     `json:"diskAnalyzerInFlightDisabled"`
+	ExcludedAccounts             []string `json:"excludedAccounts,omitempty"`
+	ResourceDirectoryID          string   `json:"resourceDirectoryId,omitempty"`
+}
+
 // ConnectorConfigAWS struct -- updates
 type ConnectorConfigAWS struct {
 	CustomerRoleARN              string                        `json:"customerRoleARN"`
@@ -641,6 +694,24 @@ type ConnectorConfigAWS struct {
 	DiskAnalyzerInFlightDisabled bool                          `json:"diskAnalyzerInFlightDisabled"`
 }
 
+// ConnectorExtraConfigAWS struct
+type ConnectorExtraConfigAWS struct {
+	OptedInRegions               []string                      `json:"optedInRegions"`
+	ExcludedAccounts             []string                      `json:"excludedAccounts"`
+	ExcludedOUs                  []string                      `json:"excludedOUs"`
+	SkipOrganizationScan         bool                          `json:"skipOrganizationScan"`
+	AuditLogMonitorEnabled       bool                          `json:"auditLogMonitorEnabled"`
+	DiskAnalyzerInFlightDisabled bool                          `json:"diskAnalyzerInFlightDisabled"`
+	CloudTrailConfig             *ConnectorConfigAWSCloudTrail `json:"cloudTrailConfig"`
+}
+
+// ConnectorAuthParamsAWS struct
+type ConnectorAuthParamsAWS struct {
+	CustomerRoleARN string                         `json:"customerRoleARN"`
+	OutpostID       string                         `json:"outpostId,omitempty"`
+	DiskAnalyzer    *ConnectorAuthConfigAWSOutpost `json:"diskAnalyzer,omitempty"`
+}
+
 // ConnectorAuthConfigAWSOutpost struct -- updates
 type ConnectorAuthConfigAWSOutpost struct {
 	Scanner ConnectorAuthConfigAWSOutpostScanner `json:"scanner"`
@@ -648,7 +719,7 @@ type ConnectorAuthConfigAWSOutpost struct {
 
 // ConnectorAuthConfigAWSOutpostScanner struct -- updates
 type ConnectorAuthConfigAWSOutpostScanner struct {
-	ExternalID string `json:"externalId"`
+	ExternalID string `json:"externalId,omitempty"`
 	RoleARN    string `json:"roleARN"`
 }
 
@@ -659,6 +730,118 @@ type ConnectorConfigAWSCloudTrail struct {
 	TrailOrg         string `json:"trailOrg"`
 }
 
+// ConnectorAuthParamsGitHub struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for URL (omitempty) to default to github.com unless GitHub Enterprise Server is used
+// Deviation for Token, AppID, InstallationID and PrivateKey (omitempty) to support either a personal access token or a GitHub App
+type ConnectorAuthParamsGitHub struct {
+	URL            string `json:"url,omitempty"`
+	Organization   string `json:"organization"`
+	Token          string `json:"token,omitempty"`
+	AppID          string `json:"appId,omitempty"`
+	InstallationID string `json:"installationId,omitempty"`
+	PrivateKey     string `json:"privateKey,omitempty"`
+}
+
+// ConnectorAuthParamsGitLab struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for URL (omitempty) to default to gitlab.com unless a self-managed instance is used
+// Deviation for Token (omitempty) because the token is write only and never read back from Wiz
+type ConnectorAuthParamsGitLab struct {
+	URL   string `json:"url,omitempty"`
+	Group string `json:"group"`
+	Token string `json:"token,omitempty"`
+}
+
+// ConnectorAuthParamsBitbucket struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for URL (omitempty) to default to bitbucket.org unless Bitbucket Data Center is used
+// Deviation for Username, AppPassword and Token (omitempty) to support either an app password or an access token
+type ConnectorAuthParamsBitbucket struct {
+	URL         string `json:"url,omitempty"`
+	Workspace   string `json:"workspace"`
+	Username    string `json:"username,omitempty"`
+	AppPassword string `json:"appPassword,omitempty"`
+	Token       string `json:"token,omitempty"`
+}
+
+// ConnectorAuthParamsAzureDevOps struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for URL (omitempty) to default to dev.azure.com unless Azure DevOps Server is used
+// Deviation for Token (omitempty) because the token is write only and never read back from Wiz
+type ConnectorAuthParamsAzureDevOps struct {
+	URL          string `json:"url,omitempty"`
+	Organization string `json:"organization"`
+	Token        string `json:"token,omitempty"`
+}
+
+// ConnectorAuthParamsECR struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for CustomerRoleARN, ExternalIDNonce, AccessKeyID and SecretAccessKey (omitempty) to support either a role or an access key
+type ConnectorAuthParamsECR struct {
+	RegistryURL     string `json:"registryUrl"`
+	CustomerRoleARN string `json:"customerRoleARN,omitempty"`
+	ExternalIDNonce string `json:"externalIdNonce,omitempty"`
+	AccessKeyID     string `json:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+}
+
+// ConnectorAuthParamsACR struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for ClientSecret (omitempty) because the secret is write only and never read back from Wiz
+type ConnectorAuthParamsACR struct {
+	RegistryURL  string `json:"registryUrl"`
+	TenantID     string `json:"tenantId"`
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
+
+// ConnectorAuthParamsGCR struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for ServiceAccountKey (json.RawMessage) to send the service account key file as is
+type ConnectorAuthParamsGCR struct {
+	RegistryURL       string          `json:"registryUrl"`
+	ServiceAccountKey json.RawMessage `json:"serviceAccountKey,omitempty"`
+}
+
+// ConnectorAuthParamsDockerHub struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for Token (omitempty) because the token is write only and never read back from Wiz
+type ConnectorAuthParamsDockerHub struct {
+	Namespace string `json:"namespace"`
+	Username  string `json:"username"`
+	Token     string `json:"token,omitempty"`
+}
+
+// ConnectorAuthParamsJFrog struct
+// We deviate from the GraphQL schema because authParams is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for Token (omitempty) because the token is write only and never read back from Wiz
+type ConnectorAuthParamsJFrog struct {
+	RegistryURL string `json:"registryUrl"`
+	Username    string `json:"username"`
+	Token       string `json:"token,omitempty"`
+}
+
+// ConnectorExtraConfigContainerRegistry struct
+// We deviate from the GraphQL schema because extraConfig is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for IncludedRepositories and ExcludedRepositories (omitempty) to scan all repositories when not set
+// Deviation for ScanFrequency (omitempty) to leave the scan frequency to Wiz when not set
+type ConnectorExtraConfigContainerRegistry struct {
+	IncludedRepositories []string `json:"includedRepositories,omitempty"`
+	ExcludedRepositories []string `json:"excludedRepositories,omitempty"`
+	ScanFrequency        string   `json:"scanFrequency,omitempty"` // enum ContainerRegistryScanFrequency (not a GraphQL enum)
+}
+
+// ConnectorExtraConfigVCS struct
+// We deviate from the GraphQL schema because extraConfig is a JSON scalar; the fields below are not defined by the GraphQL schema and are unconfirmed
+// Deviation for IncludedRepositories and ExcludedRepositories (omitempty) to scan all repositories when not set
+type ConnectorExtraConfigVCS struct {
+	IncludedRepositories       []string `json:"includedRepositories,omitempty"`
+	ExcludedRepositories       []string `json:"excludedRepositories,omitempty"`
+	PullRequestScanningEnabled bool     `json:"pullRequestScanningEnabled"`
+	PullRequestCommentsEnabled bool     `json:"pullRequestCommentsEnabled"`
+}
+
 // AutomationRule struct -- updates
 type AutomationRule struct {
 	Action               AutomationAction        `json:"action"`
@@ -1289,30 +1472,31 @@ type ControlFilters struct {
 }
 
 // IssueFilters struct
+// Deviation for Severity; the API accepts a list of severities
 type IssueFilters struct {
-	ID                  []string           `json:"id,omitempty"`
-	Search              string             `json:"search,omitempty"`
-	SecurityFramework   string             `json:"securityFramework,omitempty"`
-	SecuritySubCategory []string           `json:"securitySubCategory,omitempty"`
-	SecurityCategory    []string           `json:"securityCategory,omitempty"`
-	FrameworkCategory   []string           `json:"frameworkCategory,omitempty"`
-	StackLayer          []string           `json:"stackLayer,omitempty"` // enum TechnologyStackLayer
-	Project             []string           `json:"project,omitempty"`
-	Severity            string             `json:"severity,omitempty"` // enum Severity
-	Status              []string           `json:"status,omitempty"`   // enum IssueStatus
-	RelatedEntity       IssueEntityFilters `json:"relatedEntity,omitempty"`
-	SourceSecurityScan  string             `json:"sourceSecurityScan,omitempty"`
-	SourceControl       []string           `json:"sourceControl,omitempty"`
-	CreatedAt           IssueDateFilter    `json:"createdAt,omitempty"`
-	ResolvedAt          IssueDateFilter    `json:"resolvedAt,omitempty"`
-	ResolutionReason    []string           `json:"resolutionReason,omitempty"` // enum IssueResolutionReason
-	DueAt               IssueDateFilter    `json:"dueAt,omitempty"`
-	HasServiceTicket    *bool              `json:"hasServiceTicket,omitempty"`
-	HasNote             *bool              `json:"hasNote,omitempty"`
-	HasRemediation      *bool              `json:"hasRemediation,,omitempty"`
-	SourceControlType   []string           `json:"sourceControlType"` // enum ControlType
-	RiskEqualsAny       []string           `json:"riskEqualsAny,omitempty"`
-	RiskEqualsAll       []string           `json:"riskEqualsAll,omitempty"`
+	ID                  []string            `json:"id,omitempty"`
+	Search              string              `json:"search,omitempty"`
+	SecurityFramework   string              `json:"securityFramework,omitempty"`
+	SecuritySubCategory []string            `json:"securitySubCategory,omitempty"`
+	SecurityCategory    []string            `json:"securityCategory,omitempty"`
+	FrameworkCategory   []string            `json:"frameworkCategory,omitempty"`
+	StackLayer          []string            `json:"stackLayer,omitempty"` // enum TechnologyStackLayer
+	Project             []string            `json:"project,omitempty"`
+	Severity            []string            `json:"severity,omitempty"` // enum Severity
+	Status              []string            `json:"status,omitempty"`   // enum IssueStatus
+	RelatedEntity       *IssueEntityFilters `json:"relatedEntity,omitempty"`
+	SourceSecurityScan  string              `json:"sourceSecurityScan,omitempty"`
+	SourceControl       []string            `json:"sourceControl,omitempty"`
+	CreatedAt           *IssueDateFilter    `json:"createdAt,omitempty"`
+	ResolvedAt          *IssueDateFilter    `json:"resolvedAt,omitempty"`
+	ResolutionReason    []string            `json:"resolutionReason,omitempty"` // enum IssueResolutionReason
+	DueAt               *IssueDateFilter    `json:"dueAt,omitempty"`
+	HasServiceTicket    *bool               `json:"hasServiceTicket,omitempty"`
+	HasNote             *bool               `json:"hasNote,omitempty"`
+	HasRemediation      *bool               `json:"hasRemediation,omitempty"`
+	SourceControlType   []string            `json:"sourceControlType,omitempty"` // enum ControlType
+	RiskEqualsAny       []string            `json:"riskEqualsAny,omitempty"`
+	RiskEqualsAll       []string            `json:"riskEqualsAll,omitempty"`
 }
 
 // IssueDateFilter struct
@@ -1323,22 +1507,22 @@ type IssueDateFilter struct {
 
 // IssueEntityFilters struct
 type IssueEntityFilters struct {
-	ID              string               `json:"id,omitempty"`
-	IDs             []string             `json:"ids,omitempty"`
-	Type            string               `json:"type,omitempty"`   // scalar GraphEntityTypeValue
-	Status          []string             `json:"status,omitempty"` // enum CloudResourceStatus
-	Region          []string             `json:"region,omitempty"`
-	SubscriptionID  []string             `json:"subscriptionId,omitempty"`
-	ResourceGroupID []string             `json:"resourceGroupId,omitempty"`
-	NativeType      []string             `json:"nativeType,omitempty"`
-	CloudPlatform   []string             `json:"cloudPlatform,omitempty"` // enum CloudPlatform
-	Tag             IssueEntityTagFilter `json:"tag,omitempty"`
+	ID              string                `json:"id,omitempty"`
+	IDs             []string              `json:"ids,omitempty"`
+	Type            string                `json:"type,omitempty"`   // scalar GraphEntityTypeValue
+	Status          []string              `json:"status,omitempty"` // enum CloudResourceStatus
+	Region          []string              `json:"region,omitempty"`
+	SubscriptionID  []string              `json:"subscriptionId,omitempty"`
+	ResourceGroupID []string              `json:"resourceGroupId,omitempty"`
+	NativeType      []string              `json:"nativeType,omitempty"`
+	CloudPlatform   []string              `json:"cloudPlatform,omitempty"` // enum CloudPlatform
+	Tag             *IssueEntityTagFilter `json:"tag,omitempty"`
 }
 
 // IssueEntityTagFilter struct
 type IssueEntityTagFilter struct {
 	ContainsAll       []IssueEntityTag `json:"containsAll,omitempty"`
-	ContainsAny       []IssueEntityTag `json:"IssueEntityTag,omitempty"`
+	ContainsAny       []IssueEntityTag `json:"containsAny,omitempty"`
 	DoesNotContainAll []IssueEntityTag `json:"doesNotContainAll,omitempty"`
 	DoesNotContainAny []IssueEntityTag `json:"doesNotContainAny,omitempty"`
 }
@@ -1349,6 +1533,24 @@ type IssueEntityTag struct {
 	Value string `json:"value,omitempty"`
 }
 
+// ConfigurationFindingFilters struct
+// We deviate from the GraphQL schema and only include the criteria exposed by the automation rule filter block; the rule criteria reuse CloudConfigurationRuleFilters
+type ConfigurationFindingFilters struct {
+	Search   string                         `json:"search,omitempty"`
+	Severity []string                       `json:"severity,omitempty"` // enum Severity
+	Project  []string                       `json:"projectId,omitempty"`
+	Rule     *CloudConfigurationRuleFilters `json:"rule,omitempty"`
+}
+
+// CloudEventFilters struct
+// We deviate from the GraphQL schema and only include the criteria exposed by the automation rule filter block
+type CloudEventFilters struct {
+	CloudPlatform  []string `json:"cloudPlatform,omitempty"` // enum CloudPlatform
+	Severity       []string `json:"severity,omitempty"`      // enum Severity
+	Project        []string `json:"projectId,omitempty"`
+	SubscriptionID []string `json:"subscriptionId,omitempty"`
+}
+
 // UpdateControlsPatch struct
 type UpdateControlsPatch struct {
 	Severity              string   `json:"severity,omitempty"`
@@ -1574,11 +1776,12 @@ type UpdateHostConfigurationRuleInput struct {
 }
 
 // UpdateHostConfigurationRulePatch struct
+// Deviation for Description (pointer) to clear the description
 type UpdateHostConfigurationRulePatch struct {
 	Enabled               *bool    `json:"enabled,omitempty"`
 	SecuritySubCategories []string `json:"securitySubCategories,omitempty"`
 	Name                  string   `json:"name,omitempty"`
-	Description           string   `json:"description,omitempty"`
+	Description           *string  `json:"description,omitempty"`
 	DirectOVAL            string   `json:"directOVAL,omitempty"`
 	TargetPlatformIds     []string `json:"targetPlatformIds,omitempty"`
 }
@@ -1760,11 +1963,12 @@ type Integration struct {
 }
 
 // AutomationRuleActionInput struct
+// Deviation for ActionTemplateParams (interface) to send raw params for action template types without an ActionTemplateParamsInput field
 type AutomationRuleActionInput struct {
-	ID                   string                    `json:"id,omitempty"`
-	IntegrationID        string                    `json:"integrationId"`
-	ActionTemplateParams ActionTemplateParamsInput `json:"actionTemplateParams,omitempty"`
-	ActionTemplateType   string                    `json:"actionTemplateType"` // enum ActionTemplateType
+	ID                   string      `json:"id,omitempty"`
+	IntegrationID        string      `json:"integrationId"`
+	ActionTemplateParams interface{} `json:"actionTemplateParams,omitempty"` // ActionTemplateParamsInput or json.RawMessage
+	ActionTemplateType   string      `json:"actionTemplateType"`             // enum ActionTemplateType
 }
 
 // ActionTemplateParamsInput struct
@@ -1916,7 +2120,7 @@ type GcpPubSubIntegrationParams struct {
 	AccessConnector   Connector       `json:"accessConnector,omitempty"`
 	AccessMethod      string          `json:"accessMethod"` // enum GcpPubSubIntegrationAccessMethodType
 	ProjectID         string          `json:"projectId"`
-	ServiceAccountKey json.RawMessage `json:"serviceAccountKey.omitempty"`
+	ServiceAccountKey json.RawMessage `json:"serviceAccountKey,omitempty"`
 	TopicID           string          `json:"topicId"`
 }
 
@@ -2052,12 +2256,13 @@ type CreateAwsSNSIntegrationParamsInput struct {
 }
 
 // CreateWebhookIntegrationParamsInput struct
+// Deviation for Authorization (pointer) to omit authorization when not set
 type CreateWebhookIntegrationParamsInput struct {
-	URL           string                               `json:"url"`
-	IsOnPrem      *bool                                `json:"isOnPrem,omitempty"`
-	Authorization WebhookIntegrationAuthorizationInput `json:"authorization,omitempty"`
-	Headers       []WebhookHeaderInput                 `json:"headers,omitempty"`
-	TLSConfig     IntegrationTLSConfigInput            `json:"tlsConfig,omitempty"`
+	URL           string                                `json:"url"`
+	IsOnPrem      *bool                                 `json:"isOnPrem,omitempty"`
+	Authorization *WebhookIntegrationAuthorizationInput `json:"authorization,omitempty"`
+	Headers       []WebhookHeaderInput                  `json:"headers,omitempty"`
+	TLSConfig     IntegrationTLSConfigInput             `json:"tlsConfig,omitempty"`
 }
 
 // CreateSlackIntegrationParamsInput struct
@@ -2121,10 +2326,11 @@ type AwsSNSIntegrationAccessMethodInput struct {
 }
 
 // WebhookIntegrationAuthorizationInput struct
+// Deviation for Username, Password and Token (omitempty) to support either basic or bearer authorization
 type WebhookIntegrationAuthorizationInput struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Token    string `json:"token"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Token    string `json:"token,omitempty"`
 }
 
 // IntegrationTLSConfigInput struct
@@ -2212,12 +2418,13 @@ type UpdateAwsSNSIntegrationParamsInput struct {
 }
 
 // UpdateWebhookIntegrationParamsInput struct
+// Deviation for Authorization (pointer) to omit authorization when not set
 type UpdateWebhookIntegrationParamsInput struct {
-	URL           string                               `json:"url,omitempty"`
-	IsOnPrem      *bool                                `json:"isOnPrem,omitempty"`
-	Authorization WebhookIntegrationAuthorizationInput `json:"authorization,omitempty"`
-	Headers       []WebhookHeaderInput                 `json:"headers,omitempty"`
-	TLSConfig     IntegrationTLSConfigInput            `json:"tlsConfig,omitempty"`
+	URL           string                                `json:"url,omitempty"`
+	IsOnPrem      *bool                                 `json:"isOnPrem,omitempty"`
+	Authorization *WebhookIntegrationAuthorizationInput `json:"authorization,omitempty"`
+	Headers       []WebhookHeaderInput                  `json:"headers,omitempty"`
+	TLSConfig     IntegrationTLSConfigInput             `json:"tlsConfig,omitempty"`
 }
 
 // UpdateSlackIntegrationParamsInput struct